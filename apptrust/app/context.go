@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/artifacts"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
@@ -12,6 +13,7 @@ type Context interface {
 	GetVersionService() versions.VersionService
 	GetPackageService() packages.PackageService
 	GetSystemService() systems.SystemService
+	GetArtifactService() artifacts.ArtifactService
 	GetConfig() interface{}
 }
 
@@ -20,6 +22,7 @@ type context struct {
 	versionService     versions.VersionService
 	packageService     packages.PackageService
 	systemService      systems.SystemService
+	artifactService    artifacts.ArtifactService
 }
 
 func NewAppContext() Context {
@@ -28,6 +31,7 @@ func NewAppContext() Context {
 		versionService:     versions.NewVersionService(),
 		packageService:     packages.NewPackageService(),
 		systemService:      systems.NewSystemService(),
+		artifactService:    artifacts.NewArtifactService(),
 	}
 }
 
@@ -47,6 +51,10 @@ func (c *context) GetSystemService() systems.SystemService {
 	return c.systemService
 }
 
+func (c *context) GetArtifactService() artifacts.ArtifactService {
+	return c.artifactService
+}
+
 func (c *context) GetConfig() interface{} {
 	return nil
 }
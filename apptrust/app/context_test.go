@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	mockapplications "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	mockartifacts "github.com/jfrog/jfrog-cli-application/apptrust/service/artifacts/mocks"
 	mocksystems "github.com/jfrog/jfrog-cli-application/apptrust/service/systems/mocks"
 	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
 
@@ -16,6 +17,7 @@ func TestNewAppContext(t *testing.T) {
 	assert.NotNil(t, ctx.GetApplicationService())
 	assert.NotNil(t, ctx.GetVersionService())
 	assert.NotNil(t, ctx.GetSystemService())
+	assert.NotNil(t, ctx.GetArtifactService())
 }
 
 func TestGetApplicationService(t *testing.T) {
@@ -42,6 +44,14 @@ func TestGetSystemService(t *testing.T) {
 	assert.Equal(t, mockSystemService, ctx.GetSystemService())
 }
 
+func TestGetArtifactService(t *testing.T) {
+	mockArtifactService := &mockartifacts.MockArtifactService{}
+	ctx := &context{
+		artifactService: mockArtifactService,
+	}
+	assert.Equal(t, mockArtifactService, ctx.GetArtifactService())
+}
+
 func TestGetConfig(t *testing.T) {
 	ctx := &context{}
 	assert.Nil(t, ctx.GetConfig())
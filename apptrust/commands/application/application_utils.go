@@ -1,14 +1,51 @@
 package application
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 )
 
+func loadAppDescriptorFromSpec(ctx *components.Context) (*model.AppDescriptor, error) {
+	specFilePath := ctx.GetStringFlagValue(commands.SpecFlag)
+	spec := new(model.AppDescriptor)
+	specVars := coreutils.SpecVarsStringToMap(ctx.GetStringFlagValue(commands.SpecVarsFlag))
+	content, err := fileutils.ReadFile(specFilePath)
+	if errorutils.CheckError(err) != nil {
+		return nil, err
+	}
+
+	if len(specVars) > 0 {
+		content = coreutils.ReplaceVars(content, specVars)
+	}
+
+	err = json.Unmarshal(content, spec)
+	if errorutils.CheckError(err) != nil {
+		return nil, err
+	}
+
+	if spec.ProjectKey == "" {
+		return nil, errorutils.CheckErrorf("project_key is mandatory in spec file")
+	}
+	if strings.HasPrefix(spec.ProjectKey, "${") {
+		return nil, errorutils.CheckErrorf("project_key in spec file is the unresolved variable %s. Provide its value with --%s, such as --%s \"%s=my-project\"",
+			spec.ProjectKey, commands.SpecVarsFlag, commands.SpecVarsFlag, strings.TrimSuffix(strings.TrimPrefix(spec.ProjectKey, "${"), "}"))
+	}
+
+	return spec, nil
+}
+
 func populateApplicationFromFlags(ctx *components.Context, descriptor *model.AppDescriptor) error {
 	descriptor.ApplicationName = ctx.GetStringFlagValue(commands.ApplicationNameFlag)
 
@@ -18,31 +55,19 @@ func populateApplicationFromFlags(ctx *components.Context, descriptor *model.App
 	}
 
 	if ctx.IsFlagSet(commands.BusinessCriticalityFlag) {
-		businessCriticalityStr := ctx.GetStringFlagValue(commands.BusinessCriticalityFlag)
-		businessCriticality, err := utils.ValidateEnumFlag(
-			commands.BusinessCriticalityFlag,
-			businessCriticalityStr,
-			model.BusinessCriticalityUnspecified,
-			model.BusinessCriticalityValues)
-		if err != nil {
-			return err
-		}
+		businessCriticality := ctx.GetStringFlagValue(commands.BusinessCriticalityFlag)
 		descriptor.BusinessCriticality = &businessCriticality
 	}
 
 	if ctx.IsFlagSet(commands.MaturityLevelFlag) {
-		maturityLevelStr := ctx.GetStringFlagValue(commands.MaturityLevelFlag)
-		maturityLevel, err := utils.ValidateEnumFlag(
-			commands.MaturityLevelFlag,
-			maturityLevelStr,
-			model.MaturityLevelUnspecified,
-			model.MaturityLevelValues)
-		if err != nil {
-			return err
-		}
+		maturityLevel := ctx.GetStringFlagValue(commands.MaturityLevelFlag)
 		descriptor.MaturityLevel = &maturityLevel
 	}
 
+	if err := validateAppDescriptorEnums(descriptor); err != nil {
+		return err
+	}
+
 	if ctx.IsFlagSet(commands.LabelsFlag) {
 		labelsMap, err := utils.ParseMapFlag(ctx.GetStringFlagValue(commands.LabelsFlag))
 		if err != nil {
@@ -86,3 +111,99 @@ func populateApplicationFromFlags(ctx *components.Context, descriptor *model.App
 
 	return nil
 }
+
+// validateAppDescriptorEnums validates the business criticality and maturity level of the descriptor, if set.
+// Empty values are replaced with "unspecified".
+func validateAppDescriptorEnums(descriptor *model.AppDescriptor) error {
+	if descriptor.BusinessCriticality != nil {
+		businessCriticality, err := utils.ValidateEnumFlag(
+			commands.BusinessCriticalityFlag,
+			*descriptor.BusinessCriticality,
+			model.BusinessCriticalityUnspecified,
+			model.BusinessCriticalityValues)
+		if err != nil {
+			return err
+		}
+		descriptor.BusinessCriticality = &businessCriticality
+	}
+
+	if descriptor.MaturityLevel != nil {
+		maturityLevel, err := utils.ValidateEnumFlag(
+			commands.MaturityLevelFlag,
+			*descriptor.MaturityLevel,
+			model.MaturityLevelUnspecified,
+			model.MaturityLevelValues)
+		if err != nil {
+			return err
+		}
+		descriptor.MaturityLevel = &maturityLevel
+	}
+
+	return nil
+}
+
+// ownerUpdates holds owners to add to or remove from an existing list of owners.
+type ownerUpdates struct {
+	add    []string
+	remove []string
+}
+
+// parseOwnerUpdates builds ownerUpdates from the add/remove flags.
+// Returns nil if neither flag is set, and an error if the flags are combined with the flag that replaces the whole list.
+func parseOwnerUpdates(ctx *components.Context, replaceFlag, addFlag, removeFlag string) (*ownerUpdates, error) {
+	if !ctx.IsFlagSet(addFlag) && !ctx.IsFlagSet(removeFlag) {
+		return nil, nil
+	}
+	if ctx.IsFlagSet(replaceFlag) {
+		return nil, errorutils.CheckErrorf("the flag --%s cannot be used together with --%s or --%s", replaceFlag, addFlag, removeFlag)
+	}
+	return &ownerUpdates{
+		add:    utils.ParseSliceFlag(ctx.GetStringFlagValue(addFlag)),
+		remove: utils.ParseSliceFlag(ctx.GetStringFlagValue(removeFlag)),
+	}, nil
+}
+
+// apply returns the current owners without the removed ones, followed by the added owners that are not already present.
+func (ou *ownerUpdates) apply(current *[]string) *[]string {
+	result := []string{}
+	seen := map[string]bool{}
+	if current != nil {
+		for _, owner := range *current {
+			if !slices.Contains(ou.remove, owner) && !seen[owner] {
+				result = append(result, owner)
+				seen[owner] = true
+			}
+		}
+	}
+	for _, owner := range ou.add {
+		if owner != "" && !seen[owner] {
+			result = append(result, owner)
+			seen[owner] = true
+		}
+	}
+	return &result
+}
+
+// listBoundPackageVersions returns every package version bound to the application.
+func listBoundPackageVersions(ctx service.Context, packageService packages.PackageService, applicationKey string) ([]model.BindPackageRequest, error) {
+	bindings, err := packageService.ListPackageBindings(ctx, applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var boundPackages []model.BindPackageRequest
+	for _, binding := range bindings.Packages {
+		bindingVersions, err := packageService.GetPackageBindingVersions(ctx, applicationKey, binding.Type, binding.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, bindingVersion := range bindingVersions.Versions {
+			boundPackages = append(boundPackages, model.BindPackageRequest{
+				Type:    binding.Type,
+				Name:    binding.Name,
+				Version: bindingVersion.Version,
+			})
+		}
+	}
+	return boundPackages, nil
+}
@@ -0,0 +1,224 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type applyAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	desired            *model.AppDescriptor
+}
+
+func (aac *applyAppCommand) Run() error {
+	ctx, err := service.NewContext(*aac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	current, err := aac.applicationService.GetApplication(ctx, aac.desired.ApplicationKey)
+	if errors.Is(err, applications.ErrApplicationNotFound) {
+		createRequest := *aac.desired
+		if createRequest.ApplicationName == "" {
+			createRequest.ApplicationName = createRequest.ApplicationKey
+		}
+		return aac.applicationService.CreateApplication(ctx, &createRequest)
+	}
+	if err != nil {
+		return err
+	}
+
+	updateRequest, err := buildAppUpdatePayload(current, aac.desired)
+	if err != nil {
+		return err
+	}
+	if updateRequest == nil {
+		log.Info(fmt.Sprintf("Application \"%s\" is up to date.", aac.desired.ApplicationKey))
+		return nil
+	}
+	return aac.applicationService.UpdateApplication(ctx, updateRequest)
+}
+
+func (aac *applyAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return aac.serverDetails, nil
+}
+
+func (aac *applyAppCommand) CommandName() string {
+	return commands.AppApply
+}
+
+func (aac *applyAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) > 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if err := utils.AssertValueProvided(ctx, commands.SpecFlag); err != nil {
+		return err
+	}
+
+	var err error
+	aac.desired, err = loadAppDescriptorFromSpec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.Arguments) == 1 {
+		aac.desired.ApplicationKey = ctx.Arguments[0]
+	}
+	if aac.desired.ApplicationKey == "" {
+		return errorutils.CheckErrorf("the application key must be provided either as an argument or as application_key in the spec file")
+	}
+
+	aac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(aac)
+}
+
+// buildAppUpdatePayload compares the current state of an application with the desired one and
+// returns an update request containing only the fields that differ.
+// Returns nil if the application is already in the desired state.
+func buildAppUpdatePayload(current, desired *model.AppDescriptor) (*model.AppDescriptor, error) {
+	if desired.ProjectKey != "" && current.ProjectKey != "" && desired.ProjectKey != current.ProjectKey {
+		return nil, errorutils.CheckErrorf("application \"%s\" belongs to project \"%s\" and cannot be moved to project \"%s\"",
+			current.ApplicationKey, current.ProjectKey, desired.ProjectKey)
+	}
+
+	update := &model.AppDescriptor{ApplicationKey: desired.ApplicationKey}
+	changed := false
+
+	if desired.ApplicationName != "" && desired.ApplicationName != current.ApplicationName {
+		update.ApplicationName = desired.ApplicationName
+		changed = true
+	}
+	if stringPtrChanged(current.Description, desired.Description) {
+		update.Description = desired.Description
+		changed = true
+	}
+	if stringPtrChanged(current.MaturityLevel, desired.MaturityLevel) {
+		update.MaturityLevel = desired.MaturityLevel
+		changed = true
+	}
+	if stringPtrChanged(current.BusinessCriticality, desired.BusinessCriticality) {
+		update.BusinessCriticality = desired.BusinessCriticality
+		changed = true
+	}
+	if desired.Labels != nil {
+		if labelUpdates := diffLabels(current.Labels, *desired.Labels); labelUpdates != nil {
+			update.LabelUpdates = labelUpdates
+			changed = true
+		}
+	}
+	if ownersChanged(current.UserOwners, desired.UserOwners) {
+		update.UserOwners = desired.UserOwners
+		changed = true
+	}
+	if ownersChanged(current.GroupOwners, desired.GroupOwners) {
+		update.GroupOwners = desired.GroupOwners
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return update, nil
+}
+
+func stringPtrChanged(current, desired *string) bool {
+	if desired == nil {
+		return false
+	}
+	return current == nil || *current != *desired
+}
+
+func ownersChanged(current, desired *[]string) bool {
+	if desired == nil {
+		return false
+	}
+	var currentOwners []string
+	if current != nil {
+		currentOwners = slices.Clone(*current)
+	}
+	desiredOwners := slices.Clone(*desired)
+	sort.Strings(currentOwners)
+	sort.Strings(desiredOwners)
+	return !slices.Equal(currentOwners, desiredOwners)
+}
+
+// diffLabels returns the label additions and removals required to turn the current labels into the desired ones.
+// A label whose value changed is removed with its old value and added with its new value.
+// Returns nil if the labels are identical.
+func diffLabels(current *map[string]string, desired map[string]string) *model.LabelUpdates {
+	currentLabels := map[string]string{}
+	if current != nil {
+		currentLabels = *current
+	}
+
+	labelUpdates := &model.LabelUpdates{}
+	for key, value := range desired {
+		if currentValue, exists := currentLabels[key]; !exists || currentValue != value {
+			labelUpdates.Add = append(labelUpdates.Add, model.LabelKeyValue{Key: key, Value: value})
+		}
+	}
+	for key, value := range currentLabels {
+		if desiredValue, exists := desired[key]; !exists || desiredValue != value {
+			labelUpdates.Remove = append(labelUpdates.Remove, model.LabelKeyValue{Key: key, Value: value})
+		}
+	}
+
+	if len(labelUpdates.Add) == 0 && len(labelUpdates.Remove) == 0 {
+		return nil
+	}
+	sortLabels(labelUpdates.Add)
+	sortLabels(labelUpdates.Remove)
+	return labelUpdates
+}
+
+func sortLabels(labels []model.LabelKeyValue) {
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].Key != labels[j].Key {
+			return labels[i].Key < labels[j].Key
+		}
+		return labels[i].Value < labels[j].Value
+	})
+}
+
+func GetApplyAppCommand(appContext app.Context) components.Command {
+	cmd := &applyAppCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppApply,
+		Description: "Create an application from a spec file, or update it with the differences if it already exists.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"aa"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to apply. If omitted, application_key from the spec file is used.",
+				Optional:    true,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppApply),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,186 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApplyAppCommand_CreatesMissingApplication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", "https://example.com")
+	ctx.AddStringFlag("spec", "./testfiles/spec-with-app-key.json")
+
+	var actualPayload *model.AppDescriptor
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "ignored-app-key").
+		Return(nil, applications.ErrApplicationNotFound).Times(1)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+			actualPayload = req
+			return nil
+		}).Times(1)
+
+	cmd := &applyAppCommand{
+		applicationService: mockAppService,
+	}
+
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.AppDescriptor{
+		ApplicationKey:  "ignored-app-key",
+		ApplicationName: "test-app",
+		ProjectKey:      "test-project",
+	}, actualPayload)
+}
+
+func TestApplyAppCommand_UpdatesExistingApplication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &components.Context{
+		Arguments: []string{"app-full"},
+	}
+	ctx.AddStringFlag("url", "https://example.com")
+	ctx.AddStringFlag("spec", "./testfiles/full-spec.json")
+
+	current := &model.AppDescriptor{
+		ApplicationKey:      "app-full",
+		ApplicationName:     "test-app-full",
+		ProjectKey:          "test-project",
+		Description:         stringPtr("A comprehensive test application"),
+		MaturityLevel:       stringPtr("experimental"),
+		BusinessCriticality: stringPtr("high"),
+		Labels: &map[string]string{
+			"environment": "staging",
+			"region":      "us-east-1",
+			"obsolete":    "true",
+		},
+		UserOwners:  &[]string{"jane.smith", "john.doe"},
+		GroupOwners: &[]string{"devops-team"},
+	}
+
+	var actualPayload *model.AppDescriptor
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-full").Return(current, nil).Times(1)
+	mockAppService.EXPECT().UpdateApplication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+			actualPayload = req
+			return nil
+		}).Times(1)
+
+	cmd := &applyAppCommand{
+		applicationService: mockAppService,
+	}
+
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.AppDescriptor{
+		ApplicationKey: "app-full",
+		MaturityLevel:  stringPtr("production"),
+		LabelUpdates: &model.LabelUpdates{
+			Add: []model.LabelKeyValue{
+				{Key: "environment", Value: "production"},
+				{Key: "team", Value: "devops"},
+			},
+			Remove: []model.LabelKeyValue{
+				{Key: "environment", Value: "staging"},
+				{Key: "obsolete", Value: "true"},
+			},
+		},
+		GroupOwners: &[]string{"devops-team", "security-team"},
+	}, actualPayload)
+}
+
+func TestApplyAppCommand_UpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", "https://example.com")
+	ctx.AddStringFlag("spec", "./testfiles/spec-with-app-key.json")
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "ignored-app-key").
+		Return(&model.AppDescriptor{ApplicationKey: "ignored-app-key", ApplicationName: "test-app", ProjectKey: "test-project"}, nil).Times(1)
+
+	cmd := &applyAppCommand{
+		applicationService: mockAppService,
+	}
+
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.NoError(t, err)
+}
+
+func TestApplyAppCommand_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctxSetup      func(*components.Context)
+		getAppErr     error
+		errorContains string
+	}{
+		{
+			name:          "missing spec",
+			ctxSetup:      func(ctx *components.Context) {},
+			errorContains: "the --spec option is mandatory",
+		},
+		{
+			name: "missing application key",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag("spec", "./testfiles/minimal-spec.json")
+			},
+			errorContains: "the application key must be provided",
+		},
+		{
+			name: "get application fails",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key"}
+				ctx.AddStringFlag("spec", "./testfiles/minimal-spec.json")
+			},
+			getAppErr:     errors.New("failed to get application. Status code: 500"),
+			errorContains: "failed to get application. Status code: 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+			ctx.AddStringFlag("url", "https://example.com")
+
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			if tt.getAppErr != nil {
+				mockAppService.EXPECT().GetApplication(gomock.Any(), gomock.Any()).Return(nil, tt.getAppErr).Times(1)
+			}
+
+			cmd := &applyAppCommand{
+				applicationService: mockAppService,
+			}
+
+			err := cmd.prepareAndRunCommand(ctx)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+func TestBuildAppUpdatePayload_ProjectMismatch(t *testing.T) {
+	current := &model.AppDescriptor{ApplicationKey: "app-key", ProjectKey: "proj-a"}
+	desired := &model.AppDescriptor{ApplicationKey: "app-key", ProjectKey: "proj-b"}
+
+	update, err := buildAppUpdatePayload(current, desired)
+	assert.Nil(t, update)
+	assert.EqualError(t, err, "application \"app-key\" belongs to project \"proj-a\" and cannot be moved to project \"proj-b\"")
+}
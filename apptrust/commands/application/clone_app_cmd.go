@@ -0,0 +1,143 @@
+package application
+
+import (
+	"fmt"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type cloneAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	packageService     packages.PackageService
+	sourceKey          string
+	targetKey          string
+	targetName         string
+	projectKey         string
+	copyPackages       bool
+}
+
+func (cac *cloneAppCommand) Run() error {
+	ctx, err := service.NewContext(*cac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	source, err := cac.applicationService.GetApplication(ctx, cac.sourceKey)
+	if err != nil {
+		return err
+	}
+
+	if err = cac.applicationService.CreateApplication(ctx, cac.buildCloneRequest(source)); err != nil {
+		return err
+	}
+
+	if !cac.copyPackages {
+		return nil
+	}
+	return cac.copyPackageBindings(ctx)
+}
+
+// buildCloneRequest copies the criticality, maturity, labels and owners of the source application.
+func (cac *cloneAppCommand) buildCloneRequest(source *model.AppDescriptor) *model.AppDescriptor {
+	clone := &model.AppDescriptor{
+		ApplicationKey:      cac.targetKey,
+		ApplicationName:     cac.targetName,
+		ProjectKey:          cac.projectKey,
+		MaturityLevel:       source.MaturityLevel,
+		BusinessCriticality: source.BusinessCriticality,
+		Labels:              source.Labels,
+		UserOwners:          source.UserOwners,
+		GroupOwners:         source.GroupOwners,
+	}
+	if clone.ApplicationName == "" {
+		clone.ApplicationName = cac.targetKey
+	}
+	if clone.ProjectKey == "" {
+		clone.ProjectKey = source.ProjectKey
+	}
+	return clone
+}
+
+func (cac *cloneAppCommand) copyPackageBindings(ctx service.Context) error {
+	boundPackages, err := listBoundPackageVersions(ctx, cac.packageService, cac.sourceKey)
+	if err != nil {
+		return err
+	}
+
+	for i := range boundPackages {
+		if err = cac.packageService.BindPackage(ctx, cac.targetKey, &boundPackages[i]); err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("Copied %d package binding(s) from \"%s\" to \"%s\".", len(boundPackages), cac.sourceKey, cac.targetKey))
+	return nil
+}
+
+func (cac *cloneAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return cac.serverDetails, nil
+}
+
+func (cac *cloneAppCommand) CommandName() string {
+	return commands.AppClone
+}
+
+func (cac *cloneAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	cac.sourceKey = ctx.Arguments[0]
+	cac.targetKey = ctx.Arguments[1]
+	cac.targetName = ctx.GetStringFlagValue(commands.ApplicationNameFlag)
+	cac.projectKey = ctx.GetStringFlagValue(commands.ProjectFlag)
+	cac.copyPackages = ctx.GetBoolFlagValue(commands.CopyPackagesFlag)
+
+	var err error
+	cac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(cac)
+}
+
+func GetCloneAppCommand(appContext app.Context) components.Command {
+	cmd := &cloneAppCommand{
+		applicationService: appContext.GetApplicationService(),
+		packageService:     appContext.GetPackageService(),
+	}
+	return components.Command{
+		Name:        commands.AppClone,
+		Description: "Create a new application with the criticality, maturity, labels and owners of an existing application.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"acl"},
+		Arguments: []components.Argument{
+			{
+				Name:        "source-application-key",
+				Description: "The key of the application to clone.",
+				Optional:    false,
+			},
+			{
+				Name:        "application-key",
+				Description: "The key of the application to create.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppClone),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
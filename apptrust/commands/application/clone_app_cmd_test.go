@@ -0,0 +1,144 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	mockpackages "github.com/jfrog/jfrog-cli-application/apptrust/service/packages/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCloneAppCommand_FlagsSuite(t *testing.T) {
+	source := &model.AppDescriptor{
+		ApplicationKey:      "source-app",
+		ApplicationName:     "Source App",
+		ProjectKey:          "proj",
+		Description:         stringPtr("source description"),
+		MaturityLevel:       stringPtr("production"),
+		BusinessCriticality: stringPtr("high"),
+		Labels:              &map[string]string{"team": "devops"},
+		UserOwners:          &[]string{"admin"},
+		GroupOwners:         &[]string{"devops"},
+	}
+
+	tests := []struct {
+		name           string
+		ctxSetup       func(*components.Context)
+		expectsPayload *model.AppDescriptor
+	}{
+		{
+			name:     "defaults to source project and new key as name",
+			ctxSetup: func(ctx *components.Context) {},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey:      "new-app",
+				ApplicationName:     "new-app",
+				ProjectKey:          "proj",
+				MaturityLevel:       stringPtr("production"),
+				BusinessCriticality: stringPtr("high"),
+				Labels:              &map[string]string{"team": "devops"},
+				UserOwners:          &[]string{"admin"},
+				GroupOwners:         &[]string{"devops"},
+			},
+		},
+		{
+			name: "project and application name flags",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.ProjectFlag, "other-proj")
+				ctx.AddStringFlag(commands.ApplicationNameFlag, "New App")
+			},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey:      "new-app",
+				ApplicationName:     "New App",
+				ProjectKey:          "other-proj",
+				MaturityLevel:       stringPtr("production"),
+				BusinessCriticality: stringPtr("high"),
+				Labels:              &map[string]string{"team": "devops"},
+				UserOwners:          &[]string{"admin"},
+				GroupOwners:         &[]string{"devops"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := &components.Context{Arguments: []string{"source-app", "new-app"}}
+			tt.ctxSetup(ctx)
+			ctx.AddStringFlag("url", "https://example.com")
+
+			var actualPayload *model.AppDescriptor
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			mockAppService.EXPECT().GetApplication(gomock.Any(), "source-app").Return(source, nil).Times(1)
+			mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+					actualPayload = req
+					return nil
+				}).Times(1)
+
+			cmd := &cloneAppCommand{
+				applicationService: mockAppService,
+			}
+
+			err := cmd.prepareAndRunCommand(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectsPayload, actualPayload)
+		})
+	}
+}
+
+func TestCloneAppCommand_CopyPackages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "source-app").
+		Return(&model.AppDescriptor{ApplicationKey: "source-app", ProjectKey: "proj"}, nil).Times(1)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	mockPackageService := mockpackages.NewMockPackageService(ctrl)
+	mockPackageService.EXPECT().ListPackageBindings(gomock.Any(), "source-app").
+		Return(&model.ListPackageBindingsResponse{Packages: []model.PackageBinding{{Type: "npm", Name: "pkg"}}}, nil).Times(1)
+	mockPackageService.EXPECT().GetPackageBindingVersions(gomock.Any(), "source-app", "npm", "pkg").
+		Return(&model.PackageBindingVersionsResponse{Versions: []model.PackageBindingVersion{{Version: "1.0.0"}, {Version: "1.1.0"}}}, nil).Times(1)
+	mockPackageService.EXPECT().BindPackage(gomock.Any(), "new-app", &model.BindPackageRequest{Type: "npm", Name: "pkg", Version: "1.0.0"}).Return(nil).Times(1)
+	mockPackageService.EXPECT().BindPackage(gomock.Any(), "new-app", &model.BindPackageRequest{Type: "npm", Name: "pkg", Version: "1.1.0"}).Return(nil).Times(1)
+
+	cmd := &cloneAppCommand{
+		applicationService: mockAppService,
+		packageService:     mockPackageService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		sourceKey:          "source-app",
+		targetKey:          "new-app",
+		copyPackages:       true,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestCloneAppCommand_Run_SourceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "source-app").
+		Return(nil, errors.New("application not found: \"source-app\"")).Times(1)
+
+	cmd := &cloneAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		sourceKey:          "source-app",
+		targetKey:          "new-app",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "application not found: \"source-app\"")
+}
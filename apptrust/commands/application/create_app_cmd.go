@@ -1,8 +1,6 @@
 package application
 
 import (
-	"encoding/json"
-
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
@@ -11,9 +9,7 @@ import (
 	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
-	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
@@ -51,7 +47,7 @@ func (cac *createAppCommand) buildRequestPayload(ctx *components.Context) (*mode
 	var err error
 
 	if ctx.IsFlagSet(commands.SpecFlag) {
-		appDescriptor, err = cac.loadFromSpec(ctx)
+		appDescriptor, err = loadAppDescriptorFromSpec(ctx)
 	} else {
 		appDescriptor, err = cac.buildFromFlags(ctx)
 	}
@@ -86,31 +82,6 @@ func (cac *createAppCommand) buildFromFlags(ctx *components.Context) (*model.App
 	return descriptor, nil
 }
 
-func (cac *createAppCommand) loadFromSpec(ctx *components.Context) (*model.AppDescriptor, error) {
-	specFilePath := ctx.GetStringFlagValue(commands.SpecFlag)
-	spec := new(model.AppDescriptor)
-	specVars := coreutils.SpecVarsStringToMap(ctx.GetStringFlagValue(commands.SpecVarsFlag))
-	content, err := fileutils.ReadFile(specFilePath)
-	if errorutils.CheckError(err) != nil {
-		return nil, err
-	}
-
-	if len(specVars) > 0 {
-		content = coreutils.ReplaceVars(content, specVars)
-	}
-
-	err = json.Unmarshal(content, spec)
-	if errorutils.CheckError(err) != nil {
-		return nil, err
-	}
-
-	if spec.ProjectKey == "" {
-		return nil, errorutils.CheckErrorf("project_key is mandatory in spec file")
-	}
-
-	return spec, nil
-}
-
 func (cac *createAppCommand) prepareAndRunCommand(ctx *components.Context) error {
 	if err := validateCreateAppContext(ctx); err != nil {
 		return err
@@ -1,15 +1,23 @@
 package application
 
 import (
+	"fmt"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -17,7 +25,18 @@ import (
 type deleteAppCommand struct {
 	serverDetails      *coreConfig.ServerDetails
 	applicationService applications.ApplicationService
+	versionService     versions.VersionService
+	packageService     packages.PackageService
 	applicationKey     string
+	cascade            bool
+	force              bool
+	dryRun             bool
+}
+
+// deletionPlan lists everything a cascading delete removes before deleting the application itself.
+type deletionPlan struct {
+	versions []model.AppVersion
+	packages []model.BindPackageRequest
 }
 
 func (dac *deleteAppCommand) Run() error {
@@ -26,6 +45,66 @@ func (dac *deleteAppCommand) Run() error {
 		return err
 	}
 
+	if !dac.cascade {
+		return dac.applicationService.DeleteApplication(ctx, dac.applicationKey)
+	}
+
+	plan, err := dac.buildDeletionPlan(ctx)
+	if err != nil {
+		return err
+	}
+	dac.printDeletionPlan(plan)
+
+	if dac.dryRun {
+		return nil
+	}
+	if !dac.force && !coreutils.AskYesNo(fmt.Sprintf("Are you sure you want to delete application \"%s\" and everything listed above?", dac.applicationKey), false) {
+		log.Info("Deletion canceled.")
+		return nil
+	}
+
+	return dac.executeDeletionPlan(ctx, plan)
+}
+
+func (dac *deleteAppCommand) buildDeletionPlan(ctx service.Context) (*deletionPlan, error) {
+	appVersions, err := utils.ListAllAppVersions(ctx, dac.versionService, dac.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	boundPackages, err := listBoundPackageVersions(ctx, dac.packageService, dac.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deletionPlan{versions: appVersions, packages: boundPackages}, nil
+}
+
+func (dac *deleteAppCommand) printDeletionPlan(plan *deletionPlan) {
+	log.Output(fmt.Sprintf("Application \"%s\" will be deleted together with:", dac.applicationKey))
+	log.Output(fmt.Sprintf("  %d version(s)", len(plan.versions)))
+	for _, appVersion := range plan.versions {
+		log.Output(fmt.Sprintf("    - %s", appVersion.Version))
+	}
+	log.Output(fmt.Sprintf("  %d bound package version(s)", len(plan.packages)))
+	for _, pkg := range plan.packages {
+		log.Output(fmt.Sprintf("    - %s %s %s", pkg.Type, pkg.Name, pkg.Version))
+	}
+}
+
+// executeDeletionPlan deletes the versions first, since they may reference bound packages,
+// then unbinds the packages and finally deletes the application.
+func (dac *deleteAppCommand) executeDeletionPlan(ctx service.Context, plan *deletionPlan) error {
+	for _, appVersion := range plan.versions {
+		if err := dac.versionService.DeleteAppVersion(ctx, dac.applicationKey, appVersion.Version); err != nil {
+			return err
+		}
+	}
+	for _, pkg := range plan.packages {
+		if err := dac.packageService.UnbindPackage(ctx, dac.applicationKey, pkg.Type, pkg.Name, pkg.Version); err != nil {
+			return err
+		}
+	}
 	return dac.applicationService.DeleteApplication(ctx, dac.applicationKey)
 }
 
@@ -43,6 +122,13 @@ func (dac *deleteAppCommand) prepareAndRunCommand(ctx *components.Context) error
 	}
 
 	dac.applicationKey = ctx.Arguments[0]
+	dac.cascade = ctx.GetBoolFlagValue(commands.CascadeFlag)
+	dac.force = ctx.GetBoolFlagValue(commands.ForceFlag)
+	dac.dryRun = ctx.GetBoolFlagValue(commands.DryRunFlag)
+	if !dac.cascade && (dac.force || dac.dryRun) {
+		return errorutils.CheckErrorf("the flags --%s and --%s can only be used together with --%s",
+			commands.ForceFlag, commands.DryRunFlag, commands.CascadeFlag)
+	}
 
 	var err error
 	dac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
@@ -56,6 +142,8 @@ func (dac *deleteAppCommand) prepareAndRunCommand(ctx *components.Context) error
 func GetDeleteAppCommand(appContext app.Context) components.Command {
 	cmd := &deleteAppCommand{
 		applicationService: appContext.GetApplicationService(),
+		versionService:     appContext.GetVersionService(),
+		packageService:     appContext.GetPackageService(),
 	}
 	return components.Command{
 		Name:        commands.AppDelete,
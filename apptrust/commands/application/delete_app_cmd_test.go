@@ -5,7 +5,11 @@ import (
 	"flag"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	mockpackages "github.com/jfrog/jfrog-cli-application/apptrust/service/packages/mocks"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/stretchr/testify/assert"
@@ -74,3 +78,106 @@ func TestDeleteAppCommand_WrongNumberOfArguments(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Wrong number of arguments")
 }
+
+func TestDeleteAppCommand_Cascade(t *testing.T) {
+	tests := []struct {
+		name    string
+		dryRun  bool
+		deletes bool
+	}{
+		{
+			name:    "force deletes versions, unbinds packages and deletes the application",
+			deletes: true,
+		},
+		{
+			name:   "dry run only prints the plan",
+			dryRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			appKey := "app-key"
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockPackageService := mockpackages.NewMockPackageService(ctrl)
+
+			mockVersionService.EXPECT().ListAppVersions(gomock.Any(), appKey, map[string]string{"offset": "0", "limit": "100"}).
+				Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{{Version: "1.0.0"}, {Version: "1.0.1"}}}, nil).Times(1)
+			mockPackageService.EXPECT().ListPackageBindings(gomock.Any(), appKey).
+				Return(&model.ListPackageBindingsResponse{Packages: []model.PackageBinding{{Type: "npm", Name: "pkg"}}}, nil).Times(1)
+			mockPackageService.EXPECT().GetPackageBindingVersions(gomock.Any(), appKey, "npm", "pkg").
+				Return(&model.PackageBindingVersionsResponse{Versions: []model.PackageBindingVersion{{Version: "2.0.0"}}}, nil).Times(1)
+
+			if tt.deletes {
+				gomock.InOrder(
+					mockVersionService.EXPECT().DeleteAppVersion(gomock.Any(), appKey, "1.0.0").Return(nil),
+					mockVersionService.EXPECT().DeleteAppVersion(gomock.Any(), appKey, "1.0.1").Return(nil),
+					mockPackageService.EXPECT().UnbindPackage(gomock.Any(), appKey, "npm", "pkg", "2.0.0").Return(nil),
+					mockAppService.EXPECT().DeleteApplication(gomock.Any(), appKey).Return(nil),
+				)
+			}
+
+			cmd := &deleteAppCommand{
+				applicationService: mockAppService,
+				versionService:     mockVersionService,
+				packageService:     mockPackageService,
+				serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+				applicationKey:     appKey,
+				cascade:            true,
+				force:              true,
+				dryRun:             tt.dryRun,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteAppCommand_Cascade_StopsOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appKey := "app-key"
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockPackageService := mockpackages.NewMockPackageService(ctrl)
+
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), appKey, gomock.Any()).
+		Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{{Version: "1.0.0"}}}, nil).Times(1)
+	mockPackageService.EXPECT().ListPackageBindings(gomock.Any(), appKey).
+		Return(&model.ListPackageBindingsResponse{}, nil).Times(1)
+	mockVersionService.EXPECT().DeleteAppVersion(gomock.Any(), appKey, "1.0.0").
+		Return(errors.New("failed to delete app version. Status code: 409")).Times(1)
+
+	cmd := &deleteAppCommand{
+		applicationService: mockAppService,
+		versionService:     mockVersionService,
+		packageService:     mockPackageService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:     appKey,
+		cascade:            true,
+		force:              true,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "failed to delete app version. Status code: 409")
+}
+
+func TestDeleteAppCommand_FlagsRequireCascade(t *testing.T) {
+	for _, flagName := range []string{commands.ForceFlag, commands.DryRunFlag} {
+		t.Run(flagName, func(t *testing.T) {
+			ctx := &components.Context{Arguments: []string{"app-key"}}
+			ctx.AddBoolFlag(flagName, true)
+			ctx.AddStringFlag("url", "https://example.com")
+
+			cmd := &deleteAppCommand{}
+			err := cmd.prepareAndRunCommand(ctx)
+			assert.EqualError(t, err, "the flags --force and --dry-run can only be used together with --cascade")
+		})
+	}
+}
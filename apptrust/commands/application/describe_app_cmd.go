@@ -0,0 +1,248 @@
+package application
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type describeAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	versionService     versions.VersionService
+	packageService     packages.PackageService
+	applicationKey     string
+	format             string
+}
+
+// appDescription is the aggregated view of an application printed by app-describe.
+type appDescription struct {
+	Application   *model.AppDescriptor   `json:"application"`
+	TotalVersions int                    `json:"total_versions"`
+	Stages        []model.AppVersion     `json:"stages"`
+	Packages      []model.PackageBinding `json:"packages"`
+}
+
+type describeFieldRow struct {
+	Field string `col-name:"Field"`
+	Value string `col-name:"Value"`
+}
+
+type describeStageRow struct {
+	Stage         string `col-name:"Stage"`
+	Version       string `col-name:"Latest Version"`
+	ReleaseStatus string `col-name:"Release Status"`
+	Created       string `col-name:"Created"`
+}
+
+type describePackageRow struct {
+	Type          string `col-name:"Type"`
+	Name          string `col-name:"Name"`
+	NumVersions   string `col-name:"Versions"`
+	LatestVersion string `col-name:"Latest Version"`
+}
+
+func (dac *describeAppCommand) Run() error {
+	ctx, err := service.NewContext(*dac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	description, err := dac.buildDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	if dac.format == model.OutputFormatJson {
+		content, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	return printAppDescription(description)
+}
+
+func (dac *describeAppCommand) buildDescription(ctx service.Context) (*appDescription, error) {
+	appDescriptor, err := dac.applicationService.GetApplication(ctx, dac.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	appVersions, err := utils.ListAllAppVersions(ctx, dac.versionService, dac.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := dac.packageService.ListPackageBindings(ctx, dac.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appDescription{
+		Application:   appDescriptor,
+		TotalVersions: len(appVersions),
+		Stages:        latestVersionPerStage(appVersions),
+		Packages:      bindings.Packages,
+	}, nil
+}
+
+// latestVersionPerStage returns the most recently created version of each stage, sorted by stage name.
+// Versions that were not promoted to any stage are ignored.
+func latestVersionPerStage(appVersions []model.AppVersion) []model.AppVersion {
+	latest := map[string]model.AppVersion{}
+	for _, appVersion := range appVersions {
+		if appVersion.CurrentStage == "" {
+			continue
+		}
+		if current, exists := latest[appVersion.CurrentStage]; !exists || utils.CompareCreated(appVersion.Created, current.Created) > 0 {
+			latest[appVersion.CurrentStage] = appVersion
+		}
+	}
+
+	stages := make([]model.AppVersion, 0, len(latest))
+	for _, appVersion := range latest {
+		stages = append(stages, appVersion)
+	}
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].CurrentStage < stages[j].CurrentStage
+	})
+	return stages
+}
+
+func printAppDescription(description *appDescription) error {
+	if err := coreutils.PrintTable(describeFieldRows(description), "Application", "", false); err != nil {
+		return err
+	}
+
+	var stageRows []describeStageRow
+	for _, stage := range description.Stages {
+		stageRows = append(stageRows, describeStageRow{
+			Stage:         stage.CurrentStage,
+			Version:       stage.Version,
+			ReleaseStatus: stage.ReleaseStatus,
+			Created:       stage.Created,
+		})
+	}
+	if err := coreutils.PrintTable(stageRows, "Stages", "No versions were promoted to a stage.", false); err != nil {
+		return err
+	}
+
+	var packageRows []describePackageRow
+	for _, binding := range description.Packages {
+		packageRows = append(packageRows, describePackageRow{
+			Type:          binding.Type,
+			Name:          binding.Name,
+			NumVersions:   strconv.Itoa(binding.NumVersions),
+			LatestVersion: binding.LatestVersion,
+		})
+	}
+	return coreutils.PrintTable(packageRows, "Bound Packages", "No packages are bound to the application.", false)
+}
+
+func describeFieldRows(description *appDescription) []describeFieldRow {
+	appDescriptor := description.Application
+	rows := []describeFieldRow{
+		{Field: "Key", Value: appDescriptor.ApplicationKey},
+		{Field: "Name", Value: appDescriptor.ApplicationName},
+		{Field: "Project", Value: appDescriptor.ProjectKey},
+		{Field: "Description", Value: stringPtrValue(appDescriptor.Description)},
+		{Field: "Criticality", Value: stringPtrValue(appDescriptor.BusinessCriticality)},
+		{Field: "Maturity", Value: stringPtrValue(appDescriptor.MaturityLevel)},
+	}
+	if appDescriptor.Labels != nil {
+		var labels []string
+		for key, value := range *appDescriptor.Labels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		rows = append(rows, describeFieldRow{Field: "Labels", Value: strings.Join(labels, ", ")})
+	}
+	if appDescriptor.UserOwners != nil {
+		rows = append(rows, describeFieldRow{Field: "User Owners", Value: strings.Join(*appDescriptor.UserOwners, ", ")})
+	}
+	if appDescriptor.GroupOwners != nil {
+		rows = append(rows, describeFieldRow{Field: "Group Owners", Value: strings.Join(*appDescriptor.GroupOwners, ", ")})
+	}
+	return append(rows, describeFieldRow{Field: "Versions", Value: strconv.Itoa(description.TotalVersions)})
+}
+
+func stringPtrValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func (dac *describeAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return dac.serverDetails, nil
+}
+
+func (dac *describeAppCommand) CommandName() string {
+	return commands.AppDescribe
+}
+
+func (dac *describeAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	dac.applicationKey = ctx.Arguments[0]
+
+	var err error
+	dac.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.OutputFormatValues)
+	if err != nil {
+		return err
+	}
+
+	dac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(dac)
+}
+
+func GetDescribeAppCommand(appContext app.Context) components.Command {
+	cmd := &describeAppCommand{
+		applicationService: appContext.GetApplicationService(),
+		versionService:     appContext.GetVersionService(),
+		packageService:     appContext.GetPackageService(),
+	}
+	return components.Command{
+		Name:        commands.AppDescribe,
+		Description: "Show an application's details, the latest version in each stage and its bound packages.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ads"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to describe.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppDescribe),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
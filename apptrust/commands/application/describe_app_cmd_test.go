@@ -0,0 +1,115 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	mockpackages "github.com/jfrog/jfrog-cli-application/apptrust/service/packages/mocks"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLatestVersionPerStage(t *testing.T) {
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", CurrentStage: "PROD", ReleaseStatus: "released", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.1.0", CurrentStage: "QA", Created: "2025-02-01T10:00:00Z"},
+		{Version: "1.2.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00Z"},
+		{Version: "1.3.0", Created: "2025-04-01T10:00:00Z"},
+	}
+
+	stages := latestVersionPerStage(appVersions)
+	assert.Equal(t, []model.AppVersion{
+		{Version: "1.0.0", CurrentStage: "PROD", ReleaseStatus: "released", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.2.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00Z"},
+	}, stages)
+}
+
+func TestLatestVersionPerStage_CreatedAsInstants(t *testing.T) {
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", CurrentStage: "PROD", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.1.0", CurrentStage: "PROD", Created: "2025-01-01T11:00:00+02:00"},
+		{Version: "1.2.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00Z"},
+		{Version: "1.3.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00.500Z"},
+	}
+
+	stages := latestVersionPerStage(appVersions)
+	assert.Equal(t, []model.AppVersion{
+		{Version: "1.0.0", CurrentStage: "PROD", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.3.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00.500Z"},
+	}, stages)
+}
+
+func TestDescribeAppCommand_BuildDescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appDescriptor := &model.AppDescriptor{ApplicationKey: "app-key", ProjectKey: "proj"}
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").Return(appDescriptor, nil).Times(1)
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{
+			Versions: []model.AppVersion{
+				{Version: "1.0.0", CurrentStage: "PROD", Created: "2025-01-01T10:00:00Z"},
+				{Version: "1.1.0", CurrentStage: "PROD", Created: "2025-02-01T10:00:00Z"},
+			},
+			Total: 2,
+		}, nil).Times(1)
+
+	mockPackageService := mockpackages.NewMockPackageService(ctrl)
+	mockPackageService.EXPECT().ListPackageBindings(gomock.Any(), "app-key").
+		Return(&model.ListPackageBindingsResponse{Packages: []model.PackageBinding{{Type: "npm", Name: "pkg", NumVersions: 2, LatestVersion: "1.1.0"}}}, nil).Times(1)
+
+	cmd := &describeAppCommand{
+		applicationService: mockAppService,
+		versionService:     mockVersionService,
+		packageService:     mockPackageService,
+		applicationKey:     "app-key",
+	}
+
+	description, err := cmd.buildDescription(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &appDescription{
+		Application:   appDescriptor,
+		TotalVersions: 2,
+		Stages:        []model.AppVersion{{Version: "1.1.0", CurrentStage: "PROD", Created: "2025-02-01T10:00:00Z"}},
+		Packages:      []model.PackageBinding{{Type: "npm", Name: "pkg", NumVersions: 2, LatestVersion: "1.1.0"}},
+	}, description)
+}
+
+func TestDescribeAppCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").
+		Return(nil, errors.New("application not found: \"app-key\"")).Times(1)
+
+	cmd := &describeAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:     "app-key",
+		format:             model.OutputFormatJson,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "application not found: \"app-key\"")
+}
+
+func TestDescribeAppCommand_InvalidFormat(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.FormatFlag, "xml")
+
+	cmd := &describeAppCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --format: 'xml'. Allowed values: table and json")
+}
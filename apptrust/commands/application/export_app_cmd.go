@@ -0,0 +1,128 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type exportAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	applicationKey     string
+	outputPath         string
+	excludeProject     bool
+}
+
+func (eac *exportAppCommand) Run() error {
+	ctx, err := service.NewContext(*eac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	appDescriptor, err := eac.applicationService.GetApplication(ctx, eac.applicationKey)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(toAppSpec(appDescriptor, eac.excludeProject), "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+
+	if eac.outputPath == "" {
+		log.Output(string(content))
+		return nil
+	}
+
+	if err = os.WriteFile(eac.outputPath, append(content, '\n'), 0o644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info(fmt.Sprintf("Application \"%s\" exported to %s.", eac.applicationKey, eac.outputPath))
+	return nil
+}
+
+// projectKeySpecVar is the spec variable that replaces project_key in specs exported with --exclude-project.
+const projectKeySpecVar = "project_key"
+
+// toAppSpec converts the server state of an application into the spec file format accepted by --spec.
+// If excludeProject is set, project_key is a ${project_key} placeholder, to be provided with --spec-vars when importing.
+func toAppSpec(appDescriptor *model.AppDescriptor, excludeProject bool) *model.AppDescriptor {
+	spec := &model.AppDescriptor{
+		ApplicationKey:      appDescriptor.ApplicationKey,
+		ApplicationName:     appDescriptor.ApplicationName,
+		ProjectKey:          appDescriptor.ProjectKey,
+		Description:         appDescriptor.Description,
+		MaturityLevel:       appDescriptor.MaturityLevel,
+		BusinessCriticality: appDescriptor.BusinessCriticality,
+		Labels:              appDescriptor.Labels,
+		UserOwners:          appDescriptor.UserOwners,
+		GroupOwners:         appDescriptor.GroupOwners,
+	}
+	if excludeProject {
+		spec.ProjectKey = "${" + projectKeySpecVar + "}"
+	}
+	return spec
+}
+
+func (eac *exportAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return eac.serverDetails, nil
+}
+
+func (eac *exportAppCommand) CommandName() string {
+	return commands.AppExport
+}
+
+func (eac *exportAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	eac.applicationKey = ctx.Arguments[0]
+	eac.outputPath = ctx.GetStringFlagValue(commands.OutputFlag)
+	eac.excludeProject = ctx.GetBoolFlagValue(commands.ExcludeProjectFlag)
+
+	var err error
+	eac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(eac)
+}
+
+func GetExportAppCommand(appContext app.Context) components.Command {
+	cmd := &exportAppCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppExport,
+		Description: "Export an application to a spec file that can be used with the --spec flag.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ae"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to export.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppExport),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,124 @@
+package application
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportAppCommand_RoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverApp := &model.AppDescriptor{
+		ApplicationKey:      "app-key",
+		ApplicationName:     "App Name",
+		ProjectKey:          "proj",
+		Description:         stringPtr("description"),
+		MaturityLevel:       stringPtr("production"),
+		BusinessCriticality: stringPtr("high"),
+		Labels:              &map[string]string{"env": "prod"},
+		UserOwners:          &[]string{"admin"},
+		GroupOwners:         &[]string{"devops"},
+	}
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").Return(serverApp, nil).Times(1)
+
+	outputPath := filepath.Join(t.TempDir(), "app.json")
+	cmd := &exportAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:     "app-key",
+		outputPath:         outputPath,
+	}
+
+	err := cmd.Run()
+	require.NoError(t, err)
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.SpecFlag, outputPath)
+	loaded, err := loadAppDescriptorFromSpec(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, serverApp, loaded)
+}
+
+func TestExportAppCommand_ExcludeProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").
+		Return(&model.AppDescriptor{ApplicationKey: "app-key", ApplicationName: "App Name", ProjectKey: "proj"}, nil).Times(1)
+
+	outputPath := filepath.Join(t.TempDir(), "app.json")
+	cmd := &exportAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:     "app-key",
+		outputPath:         outputPath,
+		excludeProject:     true,
+	}
+
+	err := cmd.Run()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"project_key": "${project_key}"`)
+
+	// The exported spec can be imported into another project with app-create
+	var created *model.AppDescriptor
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+			created = req
+			return nil
+		}).Times(1)
+
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.SpecFlag, outputPath)
+	ctx.AddStringFlag(commands.SpecVarsFlag, "project_key=other-proj")
+	ctx.AddStringFlag("url", "https://example.com")
+	createCmd := &createAppCommand{applicationService: mockAppService}
+	err = createCmd.prepareAndRunCommand(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &model.AppDescriptor{ApplicationKey: "app-key", ApplicationName: "App Name", ProjectKey: "other-proj"}, created)
+}
+
+func TestExportAppCommand_ExcludeProject_MissingSpecVar(t *testing.T) {
+	specPath := filepath.Join(t.TempDir(), "app.json")
+	require.NoError(t, os.WriteFile(specPath, []byte(`{"application_key": "app-key", "project_key": "${project_key}"}`), 0o644))
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.SpecFlag, specPath)
+	_, err := loadAppDescriptorFromSpec(ctx)
+	assert.EqualError(t, err, `project_key in spec file is the unresolved variable ${project_key}. Provide its value with --spec-vars, such as --spec-vars "project_key=my-project"`)
+}
+
+func TestExportAppCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").
+		Return(nil, errors.New("failed to get application. Status code: 500")).Times(1)
+
+	cmd := &exportAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:     "app-key",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "failed to get application. Status code: 500")
+}
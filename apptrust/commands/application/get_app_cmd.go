@@ -0,0 +1,89 @@
+package application
+
+import (
+	"encoding/json"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type getAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	applicationKey     string
+}
+
+func (gac *getAppCommand) Run() error {
+	ctx, err := service.NewContext(*gac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	appDescriptor, err := gac.applicationService.GetApplication(ctx, gac.applicationKey)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(appDescriptor, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+func (gac *getAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return gac.serverDetails, nil
+}
+
+func (gac *getAppCommand) CommandName() string {
+	return commands.AppGet
+}
+
+func (gac *getAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	gac.applicationKey = ctx.Arguments[0]
+
+	var err error
+	gac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(gac)
+}
+
+func GetGetAppCommand(appContext app.Context) components.Command {
+	cmd := &getAppCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppGet,
+		Description: "Get the details of an application.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ag"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to get.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppGet),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
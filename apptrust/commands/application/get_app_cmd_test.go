@@ -0,0 +1,78 @@
+package application
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAppCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	appKey := "app-key"
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), appKey).
+		Return(&model.AppDescriptor{ApplicationKey: appKey, ProjectKey: "proj"}, nil).Times(1)
+
+	cmd := &getAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      serverDetails,
+		applicationKey:     appKey,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestGetAppCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	appKey := "app-key"
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().GetApplication(gomock.Any(), appKey).
+		Return(nil, errors.New("failed to get application. Status code: 404")).Times(1)
+
+	cmd := &getAppCommand{
+		applicationService: mockAppService,
+		serverDetails:      serverDetails,
+		applicationKey:     appKey,
+	}
+
+	err := cmd.Run()
+	assert.Error(t, err)
+	assert.Equal(t, "failed to get application. Status code: 404", err.Error())
+}
+
+func TestGetAppCommand_WrongNumberOfArguments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app := cli.NewApp()
+	set := flag.NewFlagSet("test", 0)
+	ctx := cli.NewContext(app, set, nil)
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	cmd := &getAppCommand{
+		applicationService: mockAppService,
+	}
+
+	context, err := components.ConvertContext(ctx)
+	assert.NoError(t, err)
+
+	err = cmd.prepareAndRunCommand(context)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Wrong number of arguments")
+}
@@ -0,0 +1,345 @@
+package application
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+const defaultImportThreads = 3
+
+// CSV columns accepted by app-import. Labels are given as "key1=value1;key2=value2" and owners as "owner1;owner2".
+const (
+	csvApplicationKeyColumn      = "application_key"
+	csvApplicationNameColumn     = "application_name"
+	csvProjectKeyColumn          = "project_key"
+	csvDescriptionColumn         = "description"
+	csvMaturityLevelColumn       = "maturity_level"
+	csvBusinessCriticalityColumn = "criticality"
+	csvLabelsColumn              = "labels"
+	csvUserOwnersColumn          = "user_owners"
+	csvGroupOwnersColumn         = "group_owners"
+)
+
+var csvColumns = []string{
+	csvApplicationKeyColumn,
+	csvApplicationNameColumn,
+	csvProjectKeyColumn,
+	csvDescriptionColumn,
+	csvMaturityLevelColumn,
+	csvBusinessCriticalityColumn,
+	csvLabelsColumn,
+	csvUserOwnersColumn,
+	csvGroupOwnersColumn,
+}
+
+type importAppsCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	descriptors        []*model.AppDescriptor
+	threads            int
+}
+
+type importResult struct {
+	applicationKey string
+	err            error
+}
+
+func (iac *importAppsCommand) Run() error {
+	ctx, err := service.NewContext(*iac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	results := iac.createApplications(ctx)
+	return reportImportResults(results)
+}
+
+// createApplications creates the applications using up to iac.threads concurrent requests.
+// The returned results are in the same order as iac.descriptors.
+func (iac *importAppsCommand) createApplications(ctx service.Context) []importResult {
+	results := make([]importResult, len(iac.descriptors))
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < iac.threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				descriptor := iac.descriptors[row]
+				results[row] = importResult{
+					applicationKey: descriptor.ApplicationKey,
+					err:            iac.applicationService.CreateApplication(ctx, descriptor),
+				}
+			}
+		}()
+	}
+	for row := range iac.descriptors {
+		rows <- row
+	}
+	close(rows)
+	wg.Wait()
+	return results
+}
+
+func reportImportResults(results []importResult) error {
+	failed := 0
+	log.Output("Import summary:")
+	for i, result := range results {
+		if result.err != nil {
+			failed++
+			log.Output(fmt.Sprintf("  row %d: %s - FAILED: %s", i+1, result.applicationKey, result.err.Error()))
+			continue
+		}
+		log.Output(fmt.Sprintf("  row %d: %s - OK", i+1, result.applicationKey))
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("%d of %d application(s) failed to import", failed, len(results))
+	}
+	log.Info(fmt.Sprintf("%d application(s) imported successfully.", len(results)))
+	return nil
+}
+
+func (iac *importAppsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return iac.serverDetails, nil
+}
+
+func (iac *importAppsCommand) CommandName() string {
+	return commands.AppImport
+}
+
+func (iac *importAppsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if err := utils.AssertValueProvided(ctx, commands.FileFlag); err != nil {
+		return err
+	}
+
+	var err error
+	iac.threads, err = utils.ParseNonNegativeIntFlag(ctx, commands.ThreadsFlag, defaultImportThreads)
+	if err != nil {
+		return err
+	}
+	if iac.threads == 0 {
+		return errorutils.CheckErrorf("--%s must be greater than 0", commands.ThreadsFlag)
+	}
+
+	iac.descriptors, err = loadImportFile(ctx.GetStringFlagValue(commands.FileFlag))
+	if err != nil {
+		return err
+	}
+	if err = validateImportedApplications(iac.descriptors); err != nil {
+		return err
+	}
+
+	iac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(iac)
+}
+
+// loadImportFile parses the application records of a CSV or a multi-document YAML file, according to its extension.
+func loadImportFile(filePath string) ([]*model.AppDescriptor, error) {
+	content, err := fileutils.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var descriptors []*model.AppDescriptor
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		descriptors, err = parseAppsCsv(content)
+	case ".yaml", ".yml":
+		descriptors, err = parseAppsYaml(content)
+	default:
+		return nil, errorutils.CheckErrorf("unsupported file type '%s'. Supported file types: .csv, .yaml, .yml", filepath.Ext(filePath))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(descriptors) == 0 {
+		return nil, errorutils.CheckErrorf("no applications found in %s", filePath)
+	}
+	return descriptors, nil
+}
+
+// parseAppsCsv parses a CSV file whose first line is a header of columns from csvColumns.
+// Empty cells leave the corresponding field unset.
+func parseAppsCsv(content []byte) ([]*model.AppDescriptor, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if !slices.Contains(csvColumns, header[i]) {
+			return nil, errorutils.CheckErrorf("unknown CSV column '%s'. Supported columns: %s", header[i], strings.Join(csvColumns, ", "))
+		}
+	}
+
+	var descriptors []*model.AppDescriptor
+	for i, record := range records[1:] {
+		descriptor := &model.AppDescriptor{}
+		for j, value := range record {
+			if err = setCsvField(descriptor, header[j], strings.TrimSpace(value)); err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+1, err)
+			}
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+	return descriptors, nil
+}
+
+func setCsvField(descriptor *model.AppDescriptor, column, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch column {
+	case csvApplicationKeyColumn:
+		descriptor.ApplicationKey = value
+	case csvApplicationNameColumn:
+		descriptor.ApplicationName = value
+	case csvProjectKeyColumn:
+		descriptor.ProjectKey = value
+	case csvDescriptionColumn:
+		descriptor.Description = &value
+	case csvMaturityLevelColumn:
+		descriptor.MaturityLevel = &value
+	case csvBusinessCriticalityColumn:
+		descriptor.BusinessCriticality = &value
+	case csvLabelsColumn:
+		labels, err := utils.ParseMapFlag(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", column, err)
+		}
+		descriptor.Labels = &labels
+	case csvUserOwnersColumn:
+		userOwners := utils.ParseSliceFlag(value)
+		descriptor.UserOwners = &userOwners
+	case csvGroupOwnersColumn:
+		groupOwners := utils.ParseSliceFlag(value)
+		descriptor.GroupOwners = &groupOwners
+	}
+	return nil
+}
+
+// parseAppsYaml parses a multi-document YAML file, where each document uses the same fields as the --spec file.
+func parseAppsYaml(content []byte) ([]*model.AppDescriptor, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	var descriptors []*model.AppDescriptor
+	for i := 1; ; i++ {
+		var document map[string]interface{}
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return descriptors, nil
+		}
+		if err != nil {
+			return nil, errorutils.CheckErrorf("document %d: %s", i, err.Error())
+		}
+		if document == nil {
+			continue
+		}
+
+		// Round-trip through JSON so that YAML documents share the field names of the JSON spec file.
+		documentJson, err := json.Marshal(document)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("document %d: %s", i, err.Error())
+		}
+		descriptor := &model.AppDescriptor{}
+		if err = json.Unmarshal(documentJson, descriptor); err != nil {
+			return nil, errorutils.CheckErrorf("document %d: %s", i, err.Error())
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+}
+
+// validateImportedApplications validates all records before any of them is created,
+// and returns a single error listing every invalid row.
+func validateImportedApplications(descriptors []*model.AppDescriptor) error {
+	var validationErrors []string
+	seenKeys := map[string]int{}
+	for i, descriptor := range descriptors {
+		row := i + 1
+		if err := validateImportedApplication(descriptor); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("row %d: %s", row, err.Error()))
+		}
+		if descriptor.ApplicationKey == "" {
+			continue
+		}
+		if firstRow, exists := seenKeys[descriptor.ApplicationKey]; exists {
+			validationErrors = append(validationErrors, fmt.Sprintf("row %d: application_key '%s' is already used in row %d", row, descriptor.ApplicationKey, firstRow))
+			continue
+		}
+		seenKeys[descriptor.ApplicationKey] = row
+	}
+	if len(validationErrors) > 0 {
+		return errorutils.CheckErrorf("the import file is invalid:\n%s", strings.Join(validationErrors, "\n"))
+	}
+	return nil
+}
+
+func validateImportedApplication(descriptor *model.AppDescriptor) error {
+	if descriptor.ApplicationKey == "" {
+		return errors.New("application_key is mandatory")
+	}
+	if descriptor.ProjectKey == "" {
+		return errors.New("project_key is mandatory")
+	}
+	if descriptor.LabelUpdates != nil {
+		return errors.New("label_updates is not supported when creating applications")
+	}
+	if err := validateAppDescriptorEnums(descriptor); err != nil {
+		return err
+	}
+	if descriptor.ApplicationName == "" {
+		descriptor.ApplicationName = descriptor.ApplicationKey
+	}
+	return nil
+}
+
+func GetImportAppsCommand(appContext app.Context) components.Command {
+	cmd := &importAppsCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppImport,
+		Description: "Create multiple applications from a CSV or a multi-document YAML file.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ai"},
+		Arguments:   []components.Argument{},
+		Flags:       commands.GetCommandFlags(commands.AppImport),
+		Action:      cmd.prepareAndRunCommand,
+	}
+}
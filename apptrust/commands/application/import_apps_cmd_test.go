@@ -0,0 +1,141 @@
+package application
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoadImportFile(t *testing.T) {
+	expected := []*model.AppDescriptor{
+		{
+			ApplicationKey:      "app-one",
+			ApplicationName:     "App One",
+			ProjectKey:          "proj",
+			BusinessCriticality: stringPtr("high"),
+			MaturityLevel:       stringPtr("production"),
+			Labels:              &map[string]string{"team": "devops", "env": "prod"},
+			UserOwners:          &[]string{"admin", "developer"},
+		},
+		{
+			ApplicationKey: "app-two",
+			ProjectKey:     "proj",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+	}{
+		{name: "csv", filePath: "./testfiles/apps.csv"},
+		{name: "multi-document yaml", filePath: "./testfiles/apps.yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			descriptors, err := loadImportFile(tt.filePath)
+			require.NoError(t, err)
+			assert.Equal(t, expected, descriptors)
+		})
+	}
+}
+
+func TestLoadImportFile_UnsupportedType(t *testing.T) {
+	_, err := loadImportFile("./testfiles/full-spec.json")
+	assert.EqualError(t, err, "unsupported file type '.json'. Supported file types: .csv, .yaml, .yml")
+}
+
+func TestImportAppsCommand_ValidationFailsBeforeCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.FileFlag, "./testfiles/invalid-apps.csv")
+	ctx.AddStringFlag("url", "https://example.com")
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).Times(0)
+
+	cmd := &importAppsCommand{applicationService: mockAppService}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "the import file is invalid:\n"+
+		"row 1: invalid value for --business-criticality: 'urgent'. Allowed values: unspecified, low, medium, high and critical\n"+
+		"row 2: project_key is mandatory\n"+
+		"row 3: application_key 'app-one' is already used in row 1")
+}
+
+func TestImportAppsCommand_InvalidThreads(t *testing.T) {
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.FileFlag, "./testfiles/apps.csv")
+	ctx.AddStringFlag(commands.ThreadsFlag, "0")
+
+	cmd := &importAppsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "--threads must be greater than 0")
+}
+
+func TestImportAppsCommand_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		failingKey  string
+		expectedErr string
+	}{
+		{
+			name: "all rows succeed",
+		},
+		{
+			name:        "one row fails",
+			failingKey:  "app-two",
+			expectedErr: "1 of 3 application(s) failed to import",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mu sync.Mutex
+			var createdKeys []string
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+					mu.Lock()
+					defer mu.Unlock()
+					createdKeys = append(createdKeys, req.ApplicationKey)
+					if req.ApplicationKey == tt.failingKey {
+						return errors.New("failed to create an application. Status code: 409")
+					}
+					return nil
+				}).Times(3)
+
+			cmd := &importAppsCommand{
+				applicationService: mockAppService,
+				serverDetails:      &config.ServerDetails{Url: "https://example.com"},
+				threads:            2,
+				descriptors: []*model.AppDescriptor{
+					{ApplicationKey: "app-one", ProjectKey: "proj"},
+					{ApplicationKey: "app-two", ProjectKey: "proj"},
+					{ApplicationKey: "app-three", ProjectKey: "proj"},
+				},
+			}
+
+			err := cmd.Run()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+			assert.ElementsMatch(t, []string{"app-one", "app-two", "app-three"}, createdKeys)
+		})
+	}
+}
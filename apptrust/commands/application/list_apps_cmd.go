@@ -0,0 +1,195 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+const defaultListPageSize = 100
+
+type listAppsCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	applicationService applications.ApplicationService
+	filters            map[string]string
+	limit              int
+	offset             int
+	fetchAllPages      bool
+}
+
+func (lac *listAppsCommand) Run() error {
+	ctx, err := service.NewContext(*lac.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	apps, err := lac.fetchApplications(ctx)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(apps, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+// fetchApplications returns a single page when --limit or --offset is provided,
+// otherwise it walks through all pages of the result.
+func (lac *listAppsCommand) fetchApplications(ctx service.Context) ([]model.AppDescriptor, error) {
+	if lac.fetchAllPages {
+		return utils.FetchAllPages(lac.limit, func(offset, _ int) ([]model.AppDescriptor, int, error) {
+			page, err := lac.applicationService.ListApplications(ctx, lac.pageParams(offset))
+			if err != nil {
+				return nil, 0, err
+			}
+			return page.Applications, page.Total, nil
+		})
+	}
+
+	page, err := lac.applicationService.ListApplications(ctx, lac.pageParams(lac.offset))
+	if err != nil {
+		return nil, err
+	}
+	return page.Applications, nil
+}
+
+func (lac *listAppsCommand) pageParams(offset int) map[string]string {
+	params := make(map[string]string, len(lac.filters)+2)
+	for key, value := range lac.filters {
+		params[key] = value
+	}
+	params["limit"] = strconv.Itoa(lac.limit)
+	params["offset"] = strconv.Itoa(offset)
+	return params
+}
+
+func (lac *listAppsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return lac.serverDetails, nil
+}
+
+func (lac *listAppsCommand) CommandName() string {
+	return commands.AppList
+}
+
+func (lac *listAppsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	var err error
+	lac.filters, err = buildListFilters(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = lac.parsePaginationFlags(ctx); err != nil {
+		return err
+	}
+
+	lac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(lac)
+}
+
+func (lac *listAppsCommand) parsePaginationFlags(ctx *components.Context) error {
+	lac.fetchAllPages = !ctx.IsFlagSet(commands.LimitFlag) && !ctx.IsFlagSet(commands.OffsetFlag)
+
+	var err error
+	lac.limit, err = utils.ParseNonNegativeIntFlag(ctx, commands.LimitFlag, defaultListPageSize)
+	if err != nil {
+		return err
+	}
+	if lac.limit == 0 {
+		return errorutils.CheckErrorf("--%s must be greater than 0", commands.LimitFlag)
+	}
+
+	lac.offset, err = utils.ParseNonNegativeIntFlag(ctx, commands.OffsetFlag, 0)
+	return err
+}
+
+func buildListFilters(ctx *components.Context) (map[string]string, error) {
+	filters := make(map[string]string)
+
+	if project := ctx.GetStringFlagValue(commands.ProjectFlag); project != "" {
+		filters["project_key"] = project
+	}
+
+	if ctx.IsFlagSet(commands.LabelsFlag) {
+		labelsMap, err := utils.ParseMapFlag(ctx.GetStringFlagValue(commands.LabelsFlag))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --%s: %w", commands.LabelsFlag, err)
+		}
+		if len(labelsMap) > 0 {
+			labels := make([]string, 0, len(labelsMap))
+			for key, value := range labelsMap {
+				labels = append(labels, key+":"+value)
+			}
+			sort.Strings(labels)
+			filters["labels"] = strings.Join(labels, ",")
+		}
+	}
+
+	businessCriticality, err := utils.ValidateEnumFlag(
+		commands.BusinessCriticalityFlag,
+		ctx.GetStringFlagValue(commands.BusinessCriticalityFlag),
+		"",
+		model.BusinessCriticalityValues)
+	if err != nil {
+		return nil, err
+	}
+	if businessCriticality != "" {
+		filters["criticality"] = businessCriticality
+	}
+
+	maturityLevel, err := utils.ValidateEnumFlag(
+		commands.MaturityLevelFlag,
+		ctx.GetStringFlagValue(commands.MaturityLevelFlag),
+		"",
+		model.MaturityLevelValues)
+	if err != nil {
+		return nil, err
+	}
+	if maturityLevel != "" {
+		filters["maturity_level"] = maturityLevel
+	}
+
+	return filters, nil
+}
+
+func GetListAppsCommand(appContext app.Context) components.Command {
+	cmd := &listAppsCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppList,
+		Description: "List applications, optionally filtered by project, labels, business criticality and maturity level.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"al"},
+		Arguments:   []components.Argument{},
+		Flags:       commands.GetCommandFlags(commands.AppList),
+		Action:      cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,202 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAppsCommand_FlagsSuite(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctxSetup       func(*components.Context)
+		expectsError   bool
+		errorContains  string
+		expectedParams map[string]string
+	}{
+		{
+			name:           "no filters",
+			ctxSetup:       func(ctx *components.Context) {},
+			expectedParams: map[string]string{"limit": "100", "offset": "0"},
+		},
+		{
+			name: "all filters",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.ProjectFlag, "proj")
+				ctx.AddStringFlag(commands.LabelsFlag, "team=devops;env=prod")
+				ctx.AddStringFlag(commands.BusinessCriticalityFlag, "high")
+				ctx.AddStringFlag(commands.MaturityLevelFlag, "production")
+			},
+			expectedParams: map[string]string{
+				"project_key":    "proj",
+				"labels":         "env:prod,team:devops",
+				"criticality":    "high",
+				"maturity_level": "production",
+				"limit":          "100",
+				"offset":         "0",
+			},
+		},
+		{
+			name: "limit and offset",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LimitFlag, "10")
+				ctx.AddStringFlag(commands.OffsetFlag, "20")
+			},
+			expectedParams: map[string]string{"limit": "10", "offset": "20"},
+		},
+		{
+			name: "invalid business criticality",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.BusinessCriticalityFlag, "urgent")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --business-criticality",
+		},
+		{
+			name: "invalid maturity level",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.MaturityLevelFlag, "beta")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --maturity-level",
+		},
+		{
+			name: "invalid labels",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LabelsFlag, "invalid")
+			},
+			expectsError:  true,
+			errorContains: "failed to parse --labels",
+		},
+		{
+			name: "invalid limit",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LimitFlag, "abc")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --limit",
+		},
+		{
+			name: "zero limit",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LimitFlag, "0")
+			},
+			expectsError:  true,
+			errorContains: "--limit must be greater than 0",
+		},
+		{
+			name: "negative offset",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.OffsetFlag, "-1")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --offset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+			ctx.AddStringFlag("url", "https://example.com")
+
+			var actualParams map[string]string
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			if !tt.expectsError {
+				mockAppService.EXPECT().ListApplications(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params map[string]string) (*model.ListApplicationsResponse, error) {
+						actualParams = params
+						return &model.ListApplicationsResponse{}, nil
+					}).Times(1)
+			}
+
+			cmd := &listAppsCommand{
+				applicationService: mockAppService,
+			}
+
+			err := cmd.prepareAndRunCommand(ctx)
+			if tt.expectsError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedParams, actualParams)
+			}
+		})
+	}
+}
+
+func TestListAppsCommand_FetchApplications(t *testing.T) {
+	tests := []struct {
+		name            string
+		fetchAllPages   bool
+		pages           [][]model.AppDescriptor
+		total           int
+		expectedOffsets []string
+		expectedKeys    []string
+	}{
+		{
+			name:            "walks all pages until a short page",
+			fetchAllPages:   true,
+			pages:           [][]model.AppDescriptor{{{ApplicationKey: "a"}, {ApplicationKey: "b"}}, {{ApplicationKey: "c"}}},
+			expectedOffsets: []string{"0", "2"},
+			expectedKeys:    []string{"a", "b", "c"},
+		},
+		{
+			name:            "stops when total is reached",
+			fetchAllPages:   true,
+			pages:           [][]model.AppDescriptor{{{ApplicationKey: "a"}, {ApplicationKey: "b"}}},
+			total:           2,
+			expectedOffsets: []string{"0"},
+			expectedKeys:    []string{"a", "b"},
+		},
+		{
+			name:            "single page when pagination is manual",
+			fetchAllPages:   false,
+			pages:           [][]model.AppDescriptor{{{ApplicationKey: "a"}, {ApplicationKey: "b"}}},
+			expectedOffsets: []string{"0"},
+			expectedKeys:    []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var actualOffsets []string
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			for _, page := range tt.pages {
+				mockAppService.EXPECT().ListApplications(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params map[string]string) (*model.ListApplicationsResponse, error) {
+						actualOffsets = append(actualOffsets, params["offset"])
+						return &model.ListApplicationsResponse{Applications: page, Total: tt.total}, nil
+					}).Times(1)
+			}
+
+			cmd := &listAppsCommand{
+				applicationService: mockAppService,
+				limit:              2,
+				fetchAllPages:      tt.fetchAllPages,
+			}
+
+			apps, err := cmd.fetchApplications(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOffsets, actualOffsets)
+
+			var actualKeys []string
+			for _, app := range apps {
+				actualKeys = append(actualKeys, app.ApplicationKey)
+			}
+			assert.Equal(t, tt.expectedKeys, actualKeys)
+		})
+	}
+}
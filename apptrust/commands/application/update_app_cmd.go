@@ -19,6 +19,8 @@ type updateAppCommand struct {
 	serverDetails      *coreConfig.ServerDetails
 	applicationService applications.ApplicationService
 	requestBody        *model.AppDescriptor
+	userOwnerUpdates   *ownerUpdates
+	groupOwnerUpdates  *ownerUpdates
 }
 
 func (uac *updateAppCommand) Run() error {
@@ -27,9 +29,31 @@ func (uac *updateAppCommand) Run() error {
 		return err
 	}
 
+	if uac.userOwnerUpdates != nil || uac.groupOwnerUpdates != nil {
+		if err = uac.resolveOwnerUpdates(ctx); err != nil {
+			return err
+		}
+	}
+
 	return uac.applicationService.UpdateApplication(ctx, uac.requestBody)
 }
 
+// resolveOwnerUpdates applies the owners to add and remove on top of the current owners of the application.
+func (uac *updateAppCommand) resolveOwnerUpdates(ctx service.Context) error {
+	current, err := uac.applicationService.GetApplication(ctx, uac.requestBody.ApplicationKey)
+	if err != nil {
+		return err
+	}
+
+	if uac.userOwnerUpdates != nil {
+		uac.requestBody.UserOwners = uac.userOwnerUpdates.apply(current.UserOwners)
+	}
+	if uac.groupOwnerUpdates != nil {
+		uac.requestBody.GroupOwners = uac.groupOwnerUpdates.apply(current.GroupOwners)
+	}
+	return nil
+}
+
 func (uac *updateAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
 	return uac.serverDetails, nil
 }
@@ -64,6 +88,16 @@ func (uac *updateAppCommand) prepareAndRunCommand(ctx *components.Context) error
 		return err
 	}
 
+	uac.userOwnerUpdates, err = parseOwnerUpdates(ctx, commands.UserOwnersFlag, commands.AddUserOwnersFlag, commands.RemoveUserOwnersFlag)
+	if err != nil {
+		return err
+	}
+
+	uac.groupOwnerUpdates, err = parseOwnerUpdates(ctx, commands.GroupOwnersFlag, commands.AddGroupOwnersFlag, commands.RemoveGroupOwnersFlag)
+	if err != nil {
+		return err
+	}
+
 	uac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
 	if err != nil {
 		return err
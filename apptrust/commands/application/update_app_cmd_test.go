@@ -362,6 +362,114 @@ func TestUpdateAppCommand_FlagsSuite(t *testing.T) {
 	}
 }
 
+func TestUpdateAppCommand_OwnerUpdates(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctxSetup       func(*components.Context)
+		currentApp     *model.AppDescriptor
+		expectsError   bool
+		errorContains  string
+		expectsPayload *model.AppDescriptor
+	}{
+		{
+			name: "add and remove user owners",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.AddUserOwnersFlag, "carol;alice")
+				ctx.AddStringFlag(commands.RemoveUserOwnersFlag, "bob")
+			},
+			currentApp: &model.AppDescriptor{
+				ApplicationKey: "app-key",
+				UserOwners:     &[]string{"alice", "bob"},
+				GroupOwners:    &[]string{"devops"},
+			},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey: "app-key",
+				UserOwners:     &[]string{"alice", "carol"},
+			},
+		},
+		{
+			name: "add group owner to application without owners",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.AddGroupOwnersFlag, "security")
+			},
+			currentApp: &model.AppDescriptor{ApplicationKey: "app-key"},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey: "app-key",
+				GroupOwners:    &[]string{"security"},
+			},
+		},
+		{
+			name: "remove last group owner",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.RemoveGroupOwnersFlag, "devops")
+				ctx.AddStringFlag(commands.DescriptionFlag, "desc")
+			},
+			currentApp: &model.AppDescriptor{
+				ApplicationKey: "app-key",
+				GroupOwners:    &[]string{"devops"},
+			},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey: "app-key",
+				Description:    stringPtr("desc"),
+				GroupOwners:    &[]string{},
+			},
+		},
+		{
+			name: "add-user-owners with user-owners",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.UserOwnersFlag, "alice")
+				ctx.AddStringFlag(commands.AddUserOwnersFlag, "bob")
+			},
+			expectsError:  true,
+			errorContains: "the flag --user-owners cannot be used together with --add-user-owners or --remove-user-owners",
+		},
+		{
+			name: "remove-group-owners with group-owners",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.GroupOwnersFlag, "devops")
+				ctx.AddStringFlag(commands.RemoveGroupOwnersFlag, "security")
+			},
+			expectsError:  true,
+			errorContains: "the flag --group-owners cannot be used together with --add-group-owners or --remove-group-owners",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := &components.Context{Arguments: []string{"app-key"}}
+			tt.ctxSetup(ctx)
+			ctx.AddStringFlag("url", "https://example.com")
+
+			var actualPayload *model.AppDescriptor
+			mockAppService := mockapps.NewMockApplicationService(ctrl)
+			if !tt.expectsError {
+				mockAppService.EXPECT().GetApplication(gomock.Any(), "app-key").Return(tt.currentApp, nil).Times(1)
+				mockAppService.EXPECT().UpdateApplication(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+						actualPayload = req
+						return nil
+					}).Times(1)
+			}
+
+			cmd := &updateAppCommand{
+				applicationService: mockAppService,
+			}
+
+			err := cmd.prepareAndRunCommand(ctx)
+			if tt.expectsError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectsPayload, actualPayload)
+			}
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
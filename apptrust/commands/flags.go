@@ -8,18 +8,38 @@ import (
 )
 
 const (
-	Ping            = "ping"
-	VersionCreate   = "version-create"
-	VersionPromote  = "version-promote"
-	VersionRollback = "version-rollback"
-	VersionDelete   = "version-delete"
-	VersionRelease  = "version-release"
-	VersionUpdate   = "version-update"
-	PackageBind     = "package-bind"
-	PackageUnbind   = "package-unbind"
-	AppCreate       = "app-create"
-	AppUpdate       = "app-update"
-	AppDelete       = "app-delete"
+	Ping                = "ping"
+	VersionCreate       = "version-create"
+	VersionPromote      = "version-promote"
+	VersionRollback     = "version-rollback"
+	VersionDelete       = "version-delete"
+	VersionRelease      = "version-release"
+	VersionUpdate       = "version-update"
+	VersionGet          = "version-get"
+	VersionList         = "version-list"
+	VersionContent      = "version-content"
+	VersionWait         = "version-wait"
+	VersionDiff         = "version-diff"
+	VersionPromoteChain = "version-promote-chain"
+	VersionPrune        = "version-prune"
+	VersionEvidenceAdd  = "version-evidence-add"
+	VersionEvidenceList = "version-evidence-list"
+	VersionHistory      = "version-history"
+	VersionDownload     = "version-download"
+	VersionVerify       = "version-verify"
+	VersionSbom         = "version-sbom"
+	PackageBind         = "package-bind"
+	PackageUnbind       = "package-unbind"
+	AppCreate           = "app-create"
+	AppGet              = "app-get"
+	AppList             = "app-list"
+	AppUpdate           = "app-update"
+	AppDelete           = "app-delete"
+	AppApply            = "app-apply"
+	AppExport           = "app-export"
+	AppClone            = "app-clone"
+	AppImport           = "app-import"
+	AppDescribe         = "app-describe"
 )
 
 const (
@@ -41,6 +61,10 @@ const (
 	RemoveLabelsFlag                  = "remove-labels"
 	UserOwnersFlag                    = "user-owners"
 	GroupOwnersFlag                   = "group-owners"
+	AddUserOwnersFlag                 = "add-user-owners"
+	RemoveUserOwnersFlag              = "remove-user-owners"
+	AddGroupOwnersFlag                = "add-group-owners"
+	RemoveGroupOwnersFlag             = "remove-group-owners"
 	SyncFlag                          = "sync"
 	PromotionTypeFlag                 = "promotion-type"
 	DryRunFlag                        = "dry-run"
@@ -57,10 +81,54 @@ const (
 	SourceTypeArtifactsFlag           = "source-type-artifacts"
 	PropertiesFlag                    = "properties"
 	DeletePropertiesFlag              = "delete-properties"
+	PropertiesFileFlag                = "properties-file"
+	DeletePropertiesFileFlag          = "delete-properties-file"
+	LimitFlag                         = "limit"
+	OffsetFlag                        = "offset"
+	OutputFlag                        = "output"
+	ExcludeProjectFlag                = "exclude-project"
+	CascadeFlag                       = "cascade"
+	ForceFlag                         = "force"
+	CopyPackagesFlag                  = "copy-packages"
+	FileFlag                          = "file"
+	ThreadsFlag                       = "threads"
+	FormatFlag                        = "format"
+	ReleaseStatusFlag                 = "release-status"
+	CreatedAfterFlag                  = "created-after"
+	CreatedBeforeFlag                 = "created-before"
+	SortByFlag                        = "sort-by"
+	SortOrderFlag                     = "sort-order"
+	FilterFlag                        = "filter"
+	WaitFlag                          = "wait"
+	ForFlag                           = "for"
+	TimeoutFlag                       = "timeout"
+	BumpFlag                          = "bump"
+	StagesFlag                        = "stages"
+	GatesFlag                         = "gates"
+	RestorePreviousFlag               = "restore-previous"
+	KeepLastFlag                      = "keep-last"
+	OlderThanFlag                     = "older-than"
+	OnlyDraftsFlag                    = "only-drafts"
+	ExcludeStagesFlag                 = "exclude-stages"
+	PredicateFlag                     = "predicate"
+	PredicateTypeFlag                 = "predicate-type"
+	KeyFlag                           = "key"
+	KeyAliasFlag                      = "key-alias"
+	TargetFlag                        = "target"
+	PathFlag                          = "path"
 	IncludeFilterFlag                 = "include-filter"
 	ExcludeFilterFlag                 = "exclude-filter"
 )
 
+// Keys of flags that share their name with another flag, but have a description or default value specific to some commands.
+const (
+	stageFilterFlagKey   = "stage-filter"
+	tagFilterFlagKey     = "tag-filter"
+	sbomFormatFlagKey    = "sbom-format"
+	contentFormatFlagKey = "content-format"
+	stageEventsFlagKey   = "stage-events"
+)
+
 // Flag keys mapped to their corresponding components.Flag definition.
 var flagsMap = map[string]components.Flag{
 	// Common commands flags
@@ -82,6 +150,10 @@ var flagsMap = map[string]components.Flag{
 	RemoveLabelsFlag:                  components.NewStringFlag(RemoveLabelsFlag, "List of semicolon-separated (;) labels to remove in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
 	UserOwnersFlag:                    components.NewStringFlag(UserOwnersFlag, "semicolon-separated (;) list of user owners in the form of \"user1;user2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
 	GroupOwnersFlag:                   components.NewStringFlag(GroupOwnersFlag, "semicolon-separated (;) list of group owners in the form of \"group1;group2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
+	AddUserOwnersFlag:                 components.NewStringFlag(AddUserOwnersFlag, "semicolon-separated (;) list of user owners to add in the form of \"user1;user2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
+	RemoveUserOwnersFlag:              components.NewStringFlag(RemoveUserOwnersFlag, "semicolon-separated (;) list of user owners to remove in the form of \"user1;user2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
+	AddGroupOwnersFlag:                components.NewStringFlag(AddGroupOwnersFlag, "semicolon-separated (;) list of group owners to add in the form of \"group1;group2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
+	RemoveGroupOwnersFlag:             components.NewStringFlag(RemoveGroupOwnersFlag, "semicolon-separated (;) list of group owners to remove in the form of \"group1;group2;...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
 	SyncFlag:                          components.NewBoolFlag(SyncFlag, "Whether to synchronize the operation.", components.WithBoolDefaultValueTrue()),
 	PromotionTypeFlag:                 components.NewStringFlag(PromotionTypeFlag, "The promotion type. The following values are supported: "+coreutils.ListToText(model.PromotionTypeValues), func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.PromotionTypeCopy }),
 	DryRunFlag:                        components.NewBoolFlag(DryRunFlag, "Perform a simulation of the operation.", components.WithBoolDefaultValueFalse()),
@@ -100,6 +172,46 @@ var flagsMap = map[string]components.Flag{
 	SourceTypeArtifactsFlag:           components.NewStringFlag(SourceTypeArtifactsFlag, "List of semicolon-separated (;) artifacts in the form of 'path=repo/path/to/artifact1[, sha256=hash1]; path=repo/path/to/artifact2[, sha256=hash2]' to be included in the new version.", func(f *components.StringFlag) { f.Mandatory = false }),
 	PropertiesFlag:                    components.NewStringFlag(PropertiesFlag, "Sets or updates custom properties for the application version in format 'key1=value1[,value2,...];key2=value3[,value4,...]'", func(f *components.StringFlag) { f.Mandatory = false }),
 	DeletePropertiesFlag:              components.NewStringFlag(DeletePropertiesFlag, "Remove a property key and all its values", func(f *components.StringFlag) { f.Mandatory = false }),
+	PropertiesFileFlag:                components.NewStringFlag(PropertiesFileFlag, "A path to a JSON or YAML file (.json, .yaml, .yml) mapping each property key to a list of values, such as {\"commit\": [\"Fix a, b; c\"]}. Values are used as is. Cannot be used together with --properties.", func(f *components.StringFlag) { f.Mandatory = false }),
+	DeletePropertiesFileFlag:          components.NewStringFlag(DeletePropertiesFileFlag, "A path to a JSON or YAML file (.json, .yaml, .yml) holding a list of property keys to remove. Cannot be used together with --delete-properties.", func(f *components.StringFlag) { f.Mandatory = false }),
+	LimitFlag:                         components.NewStringFlag(LimitFlag, "The maximum number of results to return. When neither --limit nor --offset is provided, all pages are fetched.", func(f *components.StringFlag) { f.Mandatory = false }),
+	OffsetFlag:                        components.NewStringFlag(OffsetFlag, "The number of results to skip before starting to return results.", func(f *components.StringFlag) { f.Mandatory = false }),
+	OutputFlag:                        components.NewStringFlag(OutputFlag, "A path to the output file. If not provided, the output is printed to the standard output.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ExcludeProjectFlag:                components.NewBoolFlag(ExcludeProjectFlag, "Replace project_key in the exported spec with a ${project_key} placeholder, so it can be reused in other projects with --spec-vars \"project_key=<project>\".", components.WithBoolDefaultValueFalse()),
+	CascadeFlag:                       components.NewBoolFlag(CascadeFlag, "Delete all versions of the application and unbind all its packages before deleting it.", components.WithBoolDefaultValueFalse()),
+	ForceFlag:                         components.NewBoolFlag(ForceFlag, "Skip the confirmation prompt.", components.WithBoolDefaultValueFalse()),
+	CopyPackagesFlag:                  components.NewBoolFlag(CopyPackagesFlag, "Bind the packages of the source application to the new application.", components.WithBoolDefaultValueFalse()),
+	FileFlag:                          components.NewStringFlag(FileFlag, "A path to a CSV file (.csv) or a multi-document YAML file (.yaml, .yml) describing the applications to create.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ThreadsFlag:                       components.NewStringFlag(ThreadsFlag, "The number of concurrent requests. Defaults to 3.", func(f *components.StringFlag) { f.Mandatory = false }),
+	FormatFlag:                        components.NewStringFlag(FormatFlag, "The output format. The following values are supported: "+coreutils.ListToText(model.OutputFormatValues)+".", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.OutputFormatTable }),
+	ReleaseStatusFlag:                 components.NewStringFlag(ReleaseStatusFlag, "Only include versions with this release status.", func(f *components.StringFlag) { f.Mandatory = false }),
+	CreatedAfterFlag:                  components.NewStringFlag(CreatedAfterFlag, "Only include versions created after this time. Supported formats: 2006-01-02 and 2006-01-02T15:04:05Z07:00.", func(f *components.StringFlag) { f.Mandatory = false }),
+	CreatedBeforeFlag:                 components.NewStringFlag(CreatedBeforeFlag, "Only include versions created before this time. Supported formats: 2006-01-02 and 2006-01-02T15:04:05Z07:00.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SortByFlag:                        components.NewStringFlag(SortByFlag, "The field to sort the versions by. The following values are supported: "+coreutils.ListToText(model.VersionSortByValues), func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.VersionSortByCreated }),
+	SortOrderFlag:                     components.NewStringFlag(SortOrderFlag, "The sort order. The following values are supported: "+coreutils.ListToText(model.SortOrderValues), func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.SortOrderDesc }),
+	FilterFlag:                        components.NewStringFlag(FilterFlag, "A wildcard pattern (* and ?) to filter artifacts by path and packages by name.", func(f *components.StringFlag) { f.Mandatory = false }),
+	WaitFlag:                          components.NewBoolFlag(WaitFlag, "Submit the operation asynchronously and wait until it completes. Fails if the operation fails or --timeout is reached.", components.WithBoolDefaultValueFalse()),
+	ForFlag:                           components.NewStringFlag(ForFlag, "The state to wait for. The following values are supported: created, promoted:<stage> and released.", func(f *components.StringFlag) { f.Mandatory = false }),
+	BumpFlag:                          components.NewStringFlag(BumpFlag, "Compute the version by bumping the highest semantic version of the application. The following values are supported: major, minor, patch and prerelease[:id]. Requires the version argument to be omitted or set to 'auto'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	StagesFlag:                        components.NewStringFlag(StagesFlag, "Comma-separated (,) list of stages to promote the version to, in order. For example: \"DEV,QA,STAGING\".", func(f *components.StringFlag) { f.Mandatory = false }),
+	GatesFlag:                         components.NewStringFlag(GatesFlag, "List of semicolon-separated (;) properties the version must have before it is promoted to a stage, in the form of \"stage1:key1[=value1];stage2:key2[=value2];...\" (wrapped by quotes).", func(f *components.StringFlag) { f.Mandatory = false }),
+	RestorePreviousFlag:               components.NewBoolFlag(RestorePreviousFlag, "Report the version that was promoted to the stage before the rolled back version, according to the promotion history, and confirm that its promotion to the stage is in effect after the rollback.", components.WithBoolDefaultValueFalse()),
+	KeepLastFlag:                      components.NewStringFlag(KeepLastFlag, "The number of most recently created versions to keep, regardless of the other rules. Must be greater than 0. Versions whose creation time is unknown are always kept.", func(f *components.StringFlag) { f.Mandatory = false }),
+	OlderThanFlag:                     components.NewStringFlag(OlderThanFlag, "Only prune versions created more than this long ago, such as 30d, 12h or 90m.", func(f *components.StringFlag) { f.Mandatory = false }),
+	OnlyDraftsFlag:                    components.NewBoolFlag(OnlyDraftsFlag, "Only prune draft versions.", components.WithBoolDefaultValueFalse()),
+	ExcludeStagesFlag:                 components.NewStringFlag(ExcludeStagesFlag, "Semicolon-separated (;) list of stages whose current versions are never pruned.", func(f *components.StringFlag) { f.Mandatory = false }),
+	PredicateFlag:                     components.NewStringFlag(PredicateFlag, "A path to a JSON file holding the evidence predicate, such as a test report or a scan result.", func(f *components.StringFlag) { f.Mandatory = false }),
+	PredicateTypeFlag:                 components.NewStringFlag(PredicateTypeFlag, "The URI identifying the type of the predicate, such as https://slsa.dev/provenance/v1.", func(f *components.StringFlag) { f.Mandatory = false }),
+	KeyFlag:                           components.NewStringFlag(KeyFlag, "A path to an unencrypted PEM private key (RSA, ECDSA or Ed25519) to sign the evidence with. If not provided, the evidence is not signed.", func(f *components.StringFlag) { f.Mandatory = false }),
+	KeyAliasFlag:                      components.NewStringFlag(KeyAliasFlag, "The ID of the signing key, used to find the matching public key when verifying the evidence.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TargetFlag:                        components.NewStringFlag(TargetFlag, "The local directory to download the artifacts to. The repository path layout of the artifacts is kept under it.", func(f *components.StringFlag) { f.Mandatory = false }),
+	PathFlag:                          components.NewStringFlag(PathFlag, "The local directory holding the files to verify.", func(f *components.StringFlag) { f.Mandatory = false }),
+	stageFilterFlagKey:                components.NewStringFlag(StageVarsFlag, "Only include versions whose current stage is this stage.", func(f *components.StringFlag) { f.Mandatory = false }),
+	tagFilterFlagKey:                  components.NewStringFlag(TagFlag, "Only include versions with this tag.", func(f *components.StringFlag) { f.Mandatory = false }),
+	stageEventsFlagKey:                components.NewStringFlag(StageVarsFlag, "Only show the events that moved the version into or out of this stage.", func(f *components.StringFlag) { f.Mandatory = false }),
+	contentFormatFlagKey:              components.NewStringFlag(FormatFlag, "The output format. The following values are supported: "+coreutils.ListToText(model.VersionContentFormatValues)+".", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.OutputFormatTable }),
+	sbomFormatFlagKey:                 components.NewStringFlag(FormatFlag, "The SBOM format. The following values are supported: "+coreutils.ListToText(model.SbomFormatValues)+".", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.SbomFormatCycloneDxJson }),
+	TimeoutFlag:                       components.NewStringFlag(TimeoutFlag, "The maximum time to wait, such as 90s, 30m or 1h.", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = "30m" }),
 }
 
 var commandFlags = map[string][]string{
@@ -109,6 +221,9 @@ var commandFlags = map[string][]string{
 		accessToken,
 		serverId,
 		SyncFlag,
+		WaitFlag,
+		TimeoutFlag,
+		BumpFlag,
 		TagFlag,
 		DraftFlag,
 		SourceTypeBuildsFlag,
@@ -127,6 +242,8 @@ var commandFlags = map[string][]string{
 		accessToken,
 		serverId,
 		SyncFlag,
+		WaitFlag,
+		TimeoutFlag,
 		PromotionTypeFlag,
 		DryRunFlag,
 		ExcludeReposFlag,
@@ -134,12 +251,28 @@ var commandFlags = map[string][]string{
 		PropsFlag,
 		OverwriteStrategyFlag,
 	},
+	VersionPromoteChain: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		StagesFlag,
+		GatesFlag,
+		TimeoutFlag,
+		PromotionTypeFlag,
+		ExcludeReposFlag,
+		IncludeReposFlag,
+		PropsFlag,
+		OverwriteStrategyFlag,
+	},
 	VersionRelease: {
 		url,
 		user,
 		accessToken,
 		serverId,
 		SyncFlag,
+		WaitFlag,
+		TimeoutFlag,
 		PromotionTypeFlag,
 		ExcludeReposFlag,
 		IncludeReposFlag,
@@ -152,12 +285,118 @@ var commandFlags = map[string][]string{
 		accessToken,
 		serverId,
 	},
+	VersionGet: {
+		url,
+		user,
+		accessToken,
+		serverId,
+	},
+	VersionList: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		stageFilterFlagKey,
+		tagFilterFlagKey,
+		ReleaseStatusFlag,
+		CreatedAfterFlag,
+		CreatedBeforeFlag,
+		SortByFlag,
+		SortOrderFlag,
+	},
+	VersionContent: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		FilterFlag,
+		contentFormatFlagKey,
+	},
+	VersionDiff: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		FormatFlag,
+	},
+	VersionWait: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		ForFlag,
+		TimeoutFlag,
+	},
 	VersionRollback: {
 		url,
 		user,
 		accessToken,
 		serverId,
 		SyncFlag,
+		WaitFlag,
+		TimeoutFlag,
+		RestorePreviousFlag,
+	},
+	VersionPrune: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		KeepLastFlag,
+		OlderThanFlag,
+		OnlyDraftsFlag,
+		ExcludeStagesFlag,
+		DryRunFlag,
+		ForceFlag,
+		ThreadsFlag,
+	},
+	VersionEvidenceAdd: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		PredicateFlag,
+		PredicateTypeFlag,
+		KeyFlag,
+		KeyAliasFlag,
+	},
+	VersionEvidenceList: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		FormatFlag,
+	},
+	VersionHistory: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		stageEventsFlagKey,
+		FormatFlag,
+	},
+	VersionDownload: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		TargetFlag,
+		ThreadsFlag,
+	},
+	VersionVerify: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		PathFlag,
+		FormatFlag,
+	},
+	VersionSbom: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		sbomFormatFlagKey,
 	},
 	VersionUpdate: {
 		url,
@@ -166,7 +405,9 @@ var commandFlags = map[string][]string{
 		serverId,
 		TagFlag,
 		PropertiesFlag,
+		PropertiesFileFlag,
 		DeletePropertiesFlag,
+		DeletePropertiesFileFlag,
 	},
 
 	PackageBind: {
@@ -206,6 +447,26 @@ var commandFlags = map[string][]string{
 		SpecVarsFlag,
 	},
 
+	AppGet: {
+		url,
+		user,
+		accessToken,
+		serverId,
+	},
+
+	AppList: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		ProjectFlag,
+		LabelsFlag,
+		BusinessCriticalityFlag,
+		MaturityLevelFlag,
+		LimitFlag,
+		OffsetFlag,
+	},
+
 	AppUpdate: {
 		url,
 		user,
@@ -220,6 +481,10 @@ var commandFlags = map[string][]string{
 		RemoveLabelsFlag,
 		UserOwnersFlag,
 		GroupOwnersFlag,
+		AddUserOwnersFlag,
+		RemoveUserOwnersFlag,
+		AddGroupOwnersFlag,
+		RemoveGroupOwnersFlag,
 	},
 
 	AppDelete: {
@@ -227,6 +492,54 @@ var commandFlags = map[string][]string{
 		user,
 		accessToken,
 		serverId,
+		CascadeFlag,
+		ForceFlag,
+		DryRunFlag,
+	},
+
+	AppApply: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		SpecFlag,
+		SpecVarsFlag,
+	},
+
+	AppExport: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		OutputFlag,
+		ExcludeProjectFlag,
+	},
+
+	AppClone: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		ApplicationNameFlag,
+		ProjectFlag,
+		CopyPackagesFlag,
+	},
+
+	AppImport: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		FileFlag,
+		ThreadsFlag,
+	},
+
+	AppDescribe: {
+		url,
+		user,
+		accessToken,
+		serverId,
+		FormatFlag,
 	},
 }
 
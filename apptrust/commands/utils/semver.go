@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Semver is a semantic version in the form of MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD].
+type Semver struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+	Build      string
+}
+
+// ParseSemver parses a semantic version. A leading "v" is accepted.
+func ParseSemver(version string) (*Semver, error) {
+	rest := strings.TrimPrefix(version, "v")
+	semver := &Semver{}
+
+	if i := strings.Index(rest, "+"); i >= 0 {
+		semver.Build = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := strings.Index(rest, "-"); i >= 0 {
+		semver.PreRelease = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return nil, errorutils.CheckErrorf("invalid semantic version: '%s' (expected format MAJOR.MINOR.PATCH)", version)
+	}
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, errorutils.CheckErrorf("invalid semantic version: '%s' (expected format MAJOR.MINOR.PATCH)", version)
+		}
+		numbers[i] = number
+	}
+	semver.Major, semver.Minor, semver.Patch = numbers[0], numbers[1], numbers[2]
+	return semver, nil
+}
+
+func (s *Semver) String() string {
+	version := fmt.Sprintf("%d.%d.%d", s.Major, s.Minor, s.Patch)
+	if s.PreRelease != "" {
+		version += "-" + s.PreRelease
+	}
+	if s.Build != "" {
+		version += "+" + s.Build
+	}
+	return version
+}
+
+// Compare returns -1, 0 or 1 according to the semantic versioning precedence of s and other.
+// Build metadata is ignored.
+func (s *Semver) Compare(other *Semver) int {
+	for _, diff := range []int{s.Major - other.Major, s.Minor - other.Minor, s.Patch - other.Patch} {
+		if diff != 0 {
+			return sign(diff)
+		}
+	}
+	return comparePreRelease(s.PreRelease, other.PreRelease)
+}
+
+// CompareVersions compares two version names by semantic versioning precedence.
+// Semantic versions are ordered before other names, which are compared lexicographically.
+func CompareVersions(a, b string) int {
+	semverA, errA := ParseSemver(a)
+	semverB, errB := ParseSemver(b)
+	switch {
+	case errA == nil && errB == nil:
+		return semverA.Compare(semverB)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// HighestSemver returns the highest semantic version among the given versions, ignoring other names.
+// Returns nil if none of the versions is a semantic version.
+func HighestSemver(versions []string) *Semver {
+	var highest *Semver
+	for _, version := range versions {
+		semver, err := ParseSemver(version)
+		if err != nil {
+			continue
+		}
+		if highest == nil || semver.Compare(highest) > 0 {
+			highest = semver
+		}
+	}
+	return highest
+}
+
+// comparePreRelease compares pre-release identifiers. A version without a pre-release has a higher precedence.
+func comparePreRelease(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if a == "" {
+		return 1
+	}
+	if b == "" {
+		return -1
+	}
+
+	identifiersA := strings.Split(a, ".")
+	identifiersB := strings.Split(b, ".")
+	for i := 0; i < len(identifiersA) && i < len(identifiersB); i++ {
+		numberA, errA := strconv.Atoi(identifiersA[i])
+		numberB, errB := strconv.Atoi(identifiersB[i])
+		switch {
+		case errA == nil && errB == nil:
+			if numberA != numberB {
+				return sign(numberA - numberB)
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if result := strings.Compare(identifiersA[i], identifiersB[i]); result != 0 {
+				return result
+			}
+		}
+	}
+	return sign(len(identifiersA) - len(identifiersB))
+}
+
+func sign(value int) int {
+	switch {
+	case value < 0:
+		return -1
+	case value > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Bump returns the next version according to the bump type, which is one of model.VersionBumpValues.
+// Bumping a pre-release to the release it precedes drops the pre-release, so 2.0.0-rc.1 bumps to 2.0.0 on major.
+// A pre-release bump increments the last numeric identifier of the pre-release, or starts a new pre-release
+// of the next patch when the version is not a pre-release. If preReleaseId is provided, it replaces the current identifier.
+func (s *Semver) Bump(bumpType, preReleaseId string) (*Semver, error) {
+	next := &Semver{Major: s.Major, Minor: s.Minor, Patch: s.Patch}
+	switch bumpType {
+	case model.VersionBumpMajor:
+		if s.PreRelease == "" || s.Minor != 0 || s.Patch != 0 {
+			next.Major, next.Minor, next.Patch = s.Major+1, 0, 0
+		}
+	case model.VersionBumpMinor:
+		if s.PreRelease == "" || s.Patch != 0 {
+			next.Minor, next.Patch = s.Minor+1, 0
+		}
+	case model.VersionBumpPatch:
+		if s.PreRelease == "" {
+			next.Patch = s.Patch + 1
+		}
+	case model.VersionBumpPreRelease:
+		next.PreRelease = s.nextPreRelease(preReleaseId)
+		if s.PreRelease == "" {
+			next.Patch = s.Patch + 1
+		}
+	default:
+		return nil, errorutils.CheckErrorf("invalid bump type: '%s'. Allowed values: %s", bumpType, coreutils.ListToText(model.VersionBumpValues))
+	}
+	return next, nil
+}
+
+func (s *Semver) nextPreRelease(preReleaseId string) string {
+	if s.PreRelease == "" || (preReleaseId != "" && !strings.HasPrefix(s.PreRelease+".", preReleaseId+".")) {
+		if preReleaseId == "" {
+			return "0"
+		}
+		return preReleaseId + ".0"
+	}
+
+	identifiers := strings.Split(s.PreRelease, ".")
+	last := len(identifiers) - 1
+	if number, err := strconv.Atoi(identifiers[last]); err == nil {
+		identifiers[last] = strconv.Itoa(number + 1)
+		return strings.Join(identifiers, ".")
+	}
+	return s.PreRelease + ".0"
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  *Semver
+		expectErr bool
+	}{
+		{"release", "1.2.3", &Semver{Major: 1, Minor: 2, Patch: 3}, false},
+		{"v prefix", "v1.2.3", &Semver{Major: 1, Minor: 2, Patch: 3}, false},
+		{"pre-release and build", "1.2.3-rc.1+build.5", &Semver{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "build.5"}, false},
+		{"missing patch", "1.2", nil, true},
+		{"not a number", "1.x.3", nil, true},
+		{"empty", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSemver(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0", "2.0.0", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.2", "1.0.0-alpha.10", -1},
+		{"1.0.0-1", "1.0.0-alpha", -1},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"1.0.0", "nightly", -1},
+		{"nightly", "beta", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CompareVersions(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSemverBump(t *testing.T) {
+	tests := []struct {
+		version      string
+		bumpType     string
+		preReleaseId string
+		expected     string
+	}{
+		{"1.2.3", "major", "", "2.0.0"},
+		{"1.2.3", "minor", "", "1.3.0"},
+		{"1.2.3", "patch", "", "1.2.4"},
+		{"1.2.3+build.5", "patch", "", "1.2.4"},
+		{"2.0.0-rc.1", "major", "", "2.0.0"},
+		{"2.1.0-rc.1", "major", "", "3.0.0"},
+		{"1.3.0-rc.1", "minor", "", "1.3.0"},
+		{"1.2.4-rc.1", "patch", "", "1.2.4"},
+		{"1.2.3", "prerelease", "", "1.2.4-0"},
+		{"1.2.3", "prerelease", "rc", "1.2.4-rc.0"},
+		{"1.2.4-rc.1", "prerelease", "", "1.2.4-rc.2"},
+		{"1.2.4-rc.1", "prerelease", "rc", "1.2.4-rc.2"},
+		{"1.2.4-alpha.3", "prerelease", "beta", "1.2.4-beta.0"},
+		{"1.2.4-rc", "prerelease", "rc", "1.2.4-rc.0"},
+		{"0.0.0", "minor", "", "0.1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.bumpType+" "+tt.preReleaseId, func(t *testing.T) {
+			semver, err := ParseSemver(tt.version)
+			assert.NoError(t, err)
+			next, err := semver.Bump(tt.bumpType, tt.preReleaseId)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, next.String())
+		})
+	}
+}
+
+func TestSemverBump_InvalidType(t *testing.T) {
+	_, err := (&Semver{Major: 1}).Bump("build", "")
+	assert.EqualError(t, err, "invalid bump type: 'build'. Allowed values: major, minor, patch and prerelease")
+}
+
+func TestHighestSemver(t *testing.T) {
+	assert.Nil(t, HighestSemver([]string{"latest", "nightly"}))
+	assert.Equal(t, &Semver{Major: 1, Minor: 10}, HighestSemver([]string{"1.2.0", "nightly", "1.10.0", "1.10.0-rc.1"}))
+}
@@ -3,9 +3,13 @@ package utils
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
@@ -17,6 +21,8 @@ import (
 const (
 	EntrySeparator = ";"
 	PartSeparator  = ":"
+
+	versionsPageSize = 100
 )
 
 func AssertValueProvided(c *components.Context, fieldName string) error {
@@ -92,6 +98,20 @@ func ValidateEnumFlag(flagName, value string, defaultValue string, allowedValues
 		flagName, value, coreutils.ListToText(allowedValues))
 }
 
+// ParseNonNegativeIntFlag parses a flag holding a non-negative integer.
+// Returns the default value if the flag is not set.
+func ParseNonNegativeIntFlag(ctx *components.Context, flagName string, defaultValue int) (int, error) {
+	if !ctx.IsFlagSet(flagName) {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(ctx.GetStringFlagValue(flagName))
+	if err != nil || value < 0 {
+		return 0, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a non-negative integer",
+			flagName, ctx.GetStringFlagValue(flagName))
+	}
+	return value, nil
+}
+
 // ParseDelimitedSlice splits a delimited string into a slice of string slices.
 // Example: input "a:1;b:2" returns [][]string{{"a","1"},{"b","2"}}
 func ParseDelimitedSlice(input string) [][]string {
@@ -191,3 +211,50 @@ func ParseLabelKeyValuePairs(flagValue string) ([]model.LabelKeyValue, error) {
 	}
 	return result, nil
 }
+
+// FetchAllPages calls fetchPage with increasing offsets, starting at 0, and collects the returned items.
+// It stops when a page shorter than pageSize is returned, or when the total reported by the server is reached.
+func FetchAllPages[T any](pageSize int, fetchPage func(offset, limit int) (items []T, total int, err error)) ([]T, error) {
+	result := []T{}
+	offset := 0
+	for {
+		items, total, err := fetchPage(offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+
+		offset += len(items)
+		if len(items) < pageSize || (total > 0 && offset >= total) {
+			return result, nil
+		}
+	}
+}
+
+// ListAllAppVersions returns every version of the application, fetching all pages.
+func ListAllAppVersions(ctx service.Context, versionService versions.VersionService, applicationKey string) ([]model.AppVersion, error) {
+	return FetchAllPages(versionsPageSize, func(offset, limit int) ([]model.AppVersion, int, error) {
+		params := map[string]string{"offset": strconv.Itoa(offset), "limit": strconv.Itoa(limit)}
+		page, err := versionService.ListAppVersions(ctx, applicationKey, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Versions, page.Total, nil
+	})
+}
+
+// CompareCreated compares two RFC3339 creation times as instants, so that fractional seconds and time zone offsets
+// are ordered correctly. A time that cannot be parsed is considered older than any valid time.
+func CompareCreated(a, b string) int {
+	createdA, errA := time.Parse(time.RFC3339, a)
+	createdB, errB := time.Parse(time.RFC3339, b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	return createdA.Compare(createdB)
+}
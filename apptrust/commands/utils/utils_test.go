@@ -274,3 +274,46 @@ func TestParseLabelKeyValuePairs(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchAllPages(t *testing.T) {
+	tests := []struct {
+		name            string
+		pages           [][]int
+		total           int
+		expectedOffsets []int
+		expected        []int
+	}{
+		{
+			name:            "stops on a short page",
+			pages:           [][]int{{1, 2}, {3}},
+			expectedOffsets: []int{0, 2},
+			expected:        []int{1, 2, 3},
+		},
+		{
+			name:            "stops on an empty page",
+			pages:           [][]int{{1, 2}, {}},
+			expectedOffsets: []int{0, 2},
+			expected:        []int{1, 2},
+		},
+		{
+			name:            "stops when total is reached",
+			pages:           [][]int{{1, 2}, {3, 4}},
+			total:           4,
+			expectedOffsets: []int{0, 2},
+			expected:        []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []int
+			result, err := FetchAllPages(2, func(offset, limit int) ([]int, int, error) {
+				assert.Equal(t, 2, limit)
+				offsets = append(offsets, offset)
+				return tt.pages[len(offsets)-1], tt.total, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expectedOffsets, offsets)
+		})
+	}
+}
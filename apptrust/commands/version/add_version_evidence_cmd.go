@@ -0,0 +1,167 @@
+package version
+
+import (
+	"crypto"
+	"encoding/json"
+	"net/url"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type addVersionEvidenceCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	predicateType  string
+	predicate      map[string]interface{}
+	signer         crypto.Signer
+	keyAlias       string
+}
+
+func (ae *addVersionEvidenceCommand) Run() error {
+	ctx, err := service.NewContext(*ae.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, ae.versionService, ae.applicationKey, ae.version)
+	if err != nil {
+		return err
+	}
+
+	versionContent, err := ae.versionService.GetAppVersionContent(ctx, ae.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	envelope, err := buildEvidenceEnvelope(ae.applicationKey, version, versionContent, ae.predicateType, ae.predicate)
+	if err != nil {
+		return err
+	}
+	if ae.signer != nil {
+		if err = signEnvelope(envelope, ae.signer, ae.keyAlias); err != nil {
+			return err
+		}
+	}
+
+	evidence, err := ae.versionService.AddAppVersionEvidence(ctx, ae.applicationKey, version, envelope)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Evidence added to version", version, "of application", ae.applicationKey+".")
+	content, err := json.MarshalIndent(evidence, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+func (ae *addVersionEvidenceCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return ae.serverDetails, nil
+}
+
+func (ae *addVersionEvidenceCommand) CommandName() string {
+	return commands.VersionEvidenceAdd
+}
+
+func (ae *addVersionEvidenceCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	ae.applicationKey = ctx.Arguments[0]
+	ae.version = ctx.Arguments[1]
+
+	var err error
+	ae.predicateType, err = parsePredicateTypeFlag(ctx)
+	if err != nil {
+		return err
+	}
+	if err = utils.AssertValueProvided(ctx, commands.PredicateFlag); err != nil {
+		return err
+	}
+	ae.predicate, err = loadPredicateFile(ctx.GetStringFlagValue(commands.PredicateFlag))
+	if err != nil {
+		return err
+	}
+
+	ae.keyAlias = ctx.GetStringFlagValue(commands.KeyAliasFlag)
+	if keyPath := ctx.GetStringFlagValue(commands.KeyFlag); keyPath != "" {
+		ae.signer, err = loadPrivateKey(keyPath)
+		if err != nil {
+			return err
+		}
+	} else if ae.keyAlias != "" {
+		return errorutils.CheckErrorf("--%s can only be used together with --%s", commands.KeyAliasFlag, commands.KeyFlag)
+	}
+
+	ae.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(ae)
+}
+
+// parsePredicateTypeFlag requires the predicate type to be an absolute URI, such as https://slsa.dev/provenance/v1.
+func parsePredicateTypeFlag(ctx *components.Context) (string, error) {
+	if err := utils.AssertValueProvided(ctx, commands.PredicateTypeFlag); err != nil {
+		return "", err
+	}
+	predicateType := ctx.GetStringFlagValue(commands.PredicateTypeFlag)
+	if parsed, err := url.Parse(predicateType); err != nil || !parsed.IsAbs() {
+		return "", errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected an absolute URI, such as https://slsa.dev/provenance/v1",
+			commands.PredicateTypeFlag, predicateType)
+	}
+	return predicateType, nil
+}
+
+func loadPredicateFile(filePath string) (map[string]interface{}, error) {
+	content, err := fileutils.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var predicate map[string]interface{}
+	if err = json.Unmarshal(content, &predicate); err != nil || predicate == nil {
+		return nil, errorutils.CheckErrorf("the predicate file %s must contain a JSON object", filePath)
+	}
+	return predicate, nil
+}
+
+func GetAddVersionEvidenceCommand(appContext app.Context) components.Command {
+	cmd := &addVersionEvidenceCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionEvidenceAdd,
+		Description: "Attach evidence, such as a test report, a scan result or an approval, to an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vea"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to attach the evidence to. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionEvidenceAdd),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,133 @@
+package version
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddVersionEvidenceCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var actualEnvelope *model.DsseEnvelope
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").Return(testEvidenceContent, nil).Times(1)
+	mockVersionService.EXPECT().AddAppVersionEvidence(gomock.Any(), "app-key", "1.0.0", gomock.Any()).
+		DoAndReturn(func(_ service.Context, _, _ string, envelope *model.DsseEnvelope) (*model.Evidence, error) {
+			actualEnvelope = envelope
+			return &model.Evidence{Id: "ev-1", PredicateType: "https://example.com/test-report/v1"}, nil
+		}).Times(1)
+
+	cmd := &addVersionEvidenceCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		predicateType:  "https://example.com/test-report/v1",
+		predicate:      map[string]interface{}{"passed": float64(42)},
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+	assert.Empty(t, actualEnvelope.Signatures)
+
+	payload, err := base64.StdEncoding.DecodeString(actualEnvelope.Payload)
+	assert.NoError(t, err)
+	statement := &model.InTotoStatement{}
+	assert.NoError(t, json.Unmarshal(payload, statement))
+	assert.Equal(t, []model.InTotoSubject{{
+		Name:   "app-key/1.0.0",
+		Digest: map[string]string{"sha256": versionContentDigest(testEvidenceContent)},
+	}}, statement.Subject)
+	assert.Equal(t, map[string]interface{}{"passed": float64(42)}, statement.Predicate)
+}
+
+func TestAddVersionEvidenceCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").Return(testEvidenceContent, nil).Times(1)
+	mockVersionService.EXPECT().AddAppVersionEvidence(gomock.Any(), "app-key", "1.0.0", gomock.Any()).
+		Return(nil, errors.New("add error")).Times(1)
+
+	cmd := &addVersionEvidenceCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		predicateType:  "https://example.com/test-report/v1",
+		predicate:      map[string]interface{}{},
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "add error")
+}
+
+func TestAddVersionEvidenceCommand_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		expectedErr string
+	}{
+		{
+			name:        "missing predicate type",
+			flags:       map[string]string{commands.PredicateFlag: "./testfiles/predicate.json"},
+			expectedErr: "the --predicate-type option is mandatory",
+		},
+		{
+			name:        "relative predicate type",
+			flags:       map[string]string{commands.PredicateTypeFlag: "test-report", commands.PredicateFlag: "./testfiles/predicate.json"},
+			expectedErr: "invalid value for --predicate-type: 'test-report'. Expected an absolute URI, such as https://slsa.dev/provenance/v1",
+		},
+		{
+			name:        "missing predicate",
+			flags:       map[string]string{commands.PredicateTypeFlag: "https://example.com/test-report/v1"},
+			expectedErr: "the --predicate option is mandatory",
+		},
+		{
+			name:        "predicate is not an object",
+			flags:       map[string]string{commands.PredicateTypeFlag: "https://example.com/test-report/v1", commands.PredicateFlag: "./testfiles/delete-properties.yaml"},
+			expectedErr: "the predicate file ./testfiles/delete-properties.yaml must contain a JSON object",
+		},
+		{
+			name: "key alias without key",
+			flags: map[string]string{
+				commands.PredicateTypeFlag: "https://example.com/test-report/v1",
+				commands.PredicateFlag:     "./testfiles/predicate.json",
+				commands.KeyAliasFlag:      "ci-key",
+			},
+			expectedErr: "--key-alias can only be used together with --key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+			for flagName, value := range tt.flags {
+				ctx.AddStringFlag(flagName, value)
+			}
+
+			cmd := &addVersionEvidenceCommand{}
+			err := cmd.prepareAndRunCommand(ctx)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestLoadPredicateFile(t *testing.T) {
+	predicate, err := loadPredicateFile("./testfiles/predicate.json")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"suite": "integration", "passed": float64(42), "failed": float64(0)}, predicate)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 
@@ -20,13 +21,21 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+// autoVersion is the version argument that asks version-create to compute the version using --bump.
+const autoVersion = "auto"
+
 type createAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
 	requestPayload *model.CreateAppVersionRequest
 	sync           bool
+	wait           bool
+	timeout        time.Duration
+	bumpType       string
+	preReleaseId   string
 }
 
 type createVersionSpec struct {
@@ -44,7 +53,45 @@ func (cv *createAppVersionCommand) Run() error {
 		return err
 	}
 
-	return cv.versionService.CreateAppVersion(ctx, cv.requestPayload, cv.sync)
+	if cv.bumpType != "" {
+		cv.requestPayload.Version, err = cv.nextVersion(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = cv.versionService.CreateAppVersion(ctx, cv.requestPayload, cv.sync); err != nil {
+		return err
+	}
+	if !cv.wait {
+		return nil
+	}
+	return newVersionWaiter(cv.versionService, cv.timeout).wait(ctx, cv.requestPayload.ApplicationKey,
+		cv.requestPayload.Version, &waitCondition{event: waitForCreated})
+}
+
+// nextVersion bumps the highest semantic version of the application. If the application has no semantic versions, 0.0.0 is bumped.
+func (cv *createAppVersionCommand) nextVersion(ctx service.Context) (string, error) {
+	appVersions, err := utils.ListAllAppVersions(ctx, cv.versionService, cv.requestPayload.ApplicationKey)
+	if err != nil {
+		return "", err
+	}
+
+	existingVersions := make([]string, 0, len(appVersions))
+	for _, appVersion := range appVersions {
+		existingVersions = append(existingVersions, appVersion.Version)
+	}
+	highest := utils.HighestSemver(existingVersions)
+	if highest == nil {
+		highest = &utils.Semver{}
+	}
+
+	next, err := highest.Bump(cv.bumpType, cv.preReleaseId)
+	if err != nil {
+		return "", err
+	}
+	log.Info("Bumping version", highest.String(), "to", next.String()+".")
+	return next.String(), nil
 }
 
 func (cv *createAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -64,7 +111,16 @@ func (cv *createAppVersionCommand) prepareAndRunCommand(ctx *components.Context)
 		return err
 	}
 	cv.serverDetails = serverDetails
-	cv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag)
+	cv.wait = ctx.GetBoolFlagValue(commands.WaitFlag)
+	cv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag) && !cv.wait
+	cv.timeout, err = parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+	cv.bumpType, cv.preReleaseId, err = parseBumpFlag(ctx)
+	if err != nil {
+		return err
+	}
 	cv.requestPayload, err = cv.buildRequestPayload(ctx)
 	if errorutils.CheckError(err) != nil {
 		return err
@@ -95,7 +151,7 @@ func (cv *createAppVersionCommand) buildRequestPayload(ctx *components.Context)
 
 	return &model.CreateAppVersionRequest{
 		ApplicationKey: ctx.Arguments[0],
-		Version:        ctx.Arguments[1],
+		Version:        versionArgument(ctx),
 		Sources:        sources,
 		Tag:            ctx.GetStringFlagValue(commands.TagFlag),
 		Draft:          ctx.GetBoolFlagValue(commands.DraftFlag),
@@ -410,11 +466,42 @@ func (cv *createAppVersionCommand) parseFilters(filterStrings []string) ([]*mode
 	return filters, nil
 }
 
+// versionArgument returns the version argument, or an empty string if it is omitted or set to auto.
+func versionArgument(ctx *components.Context) string {
+	if len(ctx.Arguments) < 2 || ctx.Arguments[1] == autoVersion {
+		return ""
+	}
+	return ctx.Arguments[1]
+}
+
+// parseBumpFlag parses the --bump flag in the form of major, minor, patch or prerelease[:id].
+// The flag is required when the version is omitted or set to auto, and not allowed otherwise.
+func parseBumpFlag(ctx *components.Context) (string, string, error) {
+	value := ctx.GetStringFlagValue(commands.BumpFlag)
+	if value == "" {
+		if versionArgument(ctx) == "" {
+			return "", "", errorutils.CheckErrorf("--%s is required when the version is omitted or set to '%s'", commands.BumpFlag, autoVersion)
+		}
+		return "", "", nil
+	}
+	if versionArgument(ctx) != "" {
+		return "", "", errorutils.CheckErrorf("--%s can only be used when the version is omitted or set to '%s'", commands.BumpFlag, autoVersion)
+	}
+
+	bumpType, preReleaseId, hasPreReleaseId := strings.Cut(value, ":")
+	if hasPreReleaseId && (bumpType != model.VersionBumpPreRelease || preReleaseId == "") {
+		return "", "", errorutils.CheckErrorf("invalid value for --%s: '%s'. Only %s accepts an identifier, in the form of %s:<id>",
+			commands.BumpFlag, value, model.VersionBumpPreRelease, model.VersionBumpPreRelease)
+	}
+	bumpType, err := utils.ValidateEnumFlag(commands.BumpFlag, bumpType, "", model.VersionBumpValues)
+	return bumpType, preReleaseId, err
+}
+
 func validateCreateAppVersionContext(ctx *components.Context) error {
 	if err := validateNoSpecAndFlagsTogether(ctx); err != nil {
 		return err
 	}
-	if len(ctx.Arguments) != 2 {
+	if len(ctx.Arguments) != 1 && len(ctx.Arguments) != 2 {
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
@@ -449,8 +536,8 @@ func GetCreateAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The version number (in SemVer format) for the new application version.",
-				Optional:    false,
+				Description: "The version number (in SemVer format) for the new application version. Omit it or set it to '" + autoVersion + "' to compute it with --" + commands.BumpFlag + ".",
+				Optional:    true,
 			},
 		},
 		Flags:  commands.GetCommandFlags(commands.VersionCreate),
@@ -1663,3 +1663,116 @@ func TestBuildRequestPayload_Filters(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBumpFlag(t *testing.T) {
+	tests := []struct {
+		name                 string
+		arguments            []string
+		bump                 string
+		expectedBumpType     string
+		expectedPreReleaseId string
+		expectedErr          string
+	}{
+		{name: "explicit version", arguments: []string{"app-key", "1.0.0"}},
+		{name: "omitted version", arguments: []string{"app-key"}, bump: "minor", expectedBumpType: "minor"},
+		{name: "auto version", arguments: []string{"app-key", "auto"}, bump: "major", expectedBumpType: "major"},
+		{name: "pre-release with identifier", arguments: []string{"app-key"}, bump: "prerelease:rc", expectedBumpType: "prerelease", expectedPreReleaseId: "rc"},
+		{
+			name:        "omitted version without bump",
+			arguments:   []string{"app-key"},
+			expectedErr: "--bump is required when the version is omitted or set to 'auto'",
+		},
+		{
+			name:        "explicit version with bump",
+			arguments:   []string{"app-key", "1.0.0"},
+			bump:        "patch",
+			expectedErr: "--bump can only be used when the version is omitted or set to 'auto'",
+		},
+		{
+			name:        "identifier on patch",
+			arguments:   []string{"app-key"},
+			bump:        "patch:rc",
+			expectedErr: "invalid value for --bump: 'patch:rc'. Only prerelease accepts an identifier, in the form of prerelease:<id>",
+		},
+		{
+			name:        "invalid bump type",
+			arguments:   []string{"app-key"},
+			bump:        "build",
+			expectedErr: "invalid value for --bump: 'build'. Allowed values: major, minor, patch and prerelease",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: tt.arguments}
+			if tt.bump != "" {
+				ctx.AddStringFlag(commands.BumpFlag, tt.bump)
+			}
+
+			bumpType, preReleaseId, err := parseBumpFlag(ctx)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBumpType, bumpType)
+			assert.Equal(t, tt.expectedPreReleaseId, preReleaseId)
+		})
+	}
+}
+
+func TestCreateAppVersionCommand_Run_Bump(t *testing.T) {
+	tests := []struct {
+		name             string
+		existingVersions []model.AppVersion
+		bumpType         string
+		preReleaseId     string
+		expectedVersion  string
+	}{
+		{
+			name:             "minor bump of highest semver",
+			existingVersions: []model.AppVersion{{Version: "1.2.0"}, {Version: "1.10.3"}, {Version: "nightly"}},
+			bumpType:         model.VersionBumpMinor,
+			expectedVersion:  "1.11.0",
+		},
+		{
+			name:             "pre-release bump",
+			existingVersions: []model.AppVersion{{Version: "2.0.0-rc.1"}, {Version: "1.9.0"}},
+			bumpType:         model.VersionBumpPreRelease,
+			preReleaseId:     "rc",
+			expectedVersion:  "2.0.0-rc.2",
+		},
+		{
+			name:            "first version",
+			bumpType:        model.VersionBumpPatch,
+			expectedVersion: "0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+				Return(&model.ListAppVersionsResponse{Versions: tt.existingVersions, Total: len(tt.existingVersions)}, nil).Times(1)
+			mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), &model.CreateAppVersionRequest{
+				ApplicationKey: "app-key",
+				Version:        tt.expectedVersion,
+			}, true).Return(nil).Times(1)
+
+			cmd := &createAppVersionCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				requestPayload: &model.CreateAppVersionRequest{ApplicationKey: "app-key"},
+				sync:           true,
+				bumpType:       tt.bumpType,
+				preReleaseId:   tt.preReleaseId,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
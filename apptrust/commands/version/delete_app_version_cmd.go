@@ -26,7 +26,12 @@ func (dv *deleteAppVersionCommand) Run() error {
 		return err
 	}
 
-	return dv.versionService.DeleteAppVersion(ctx, dv.applicationKey, dv.version)
+	version, err := resolveVersion(ctx, dv.versionService, dv.applicationKey, dv.version)
+	if err != nil {
+		return err
+	}
+
+	return dv.versionService.DeleteAppVersion(ctx, dv.applicationKey, version)
 }
 
 func (dv *deleteAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -69,7 +74,7 @@ func GetDeleteAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The name of the version to delete.",
+				Description: "The name of the version to delete. " + versionSelectorsDescription,
 				Optional:    false,
 			},
 		},
@@ -0,0 +1,380 @@
+package version
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+	changeMoved   = "moved"
+)
+
+type diffAppVersionsCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	fromVersion    string
+	toVersion      string
+	format         string
+}
+
+// versionDiff lists the differences between two versions of an application.
+// Every change is described as going from the first version to the second one.
+type versionDiff struct {
+	ApplicationKey string           `json:"application_key"`
+	FromVersion    string           `json:"from_version"`
+	ToVersion      string           `json:"to_version"`
+	Tag            *tagChange       `json:"tag,omitempty"`
+	Properties     []propertyChange `json:"properties"`
+	Packages       []packageChange  `json:"packages"`
+	Artifacts      []artifactChange `json:"artifacts"`
+}
+
+type tagChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type propertyChange struct {
+	Change string   `json:"change"`
+	Key    string   `json:"key"`
+	From   []string `json:"from,omitempty"`
+	To     []string `json:"to,omitempty"`
+}
+
+type packageChange struct {
+	Change      string `json:"change" col-name:"Change"`
+	Type        string `json:"type" col-name:"Type"`
+	Name        string `json:"name" col-name:"Name"`
+	FromVersion string `json:"from_version,omitempty" col-name:"From Version"`
+	ToVersion   string `json:"to_version,omitempty" col-name:"To Version"`
+}
+
+type artifactChange struct {
+	Change     string `json:"change" col-name:"Change"`
+	Path       string `json:"path" col-name:"Path"`
+	FromPath   string `json:"from_path,omitempty" col-name:"From Path"`
+	FromSha256 string `json:"from_sha256,omitempty" col-name:"From SHA256"`
+	ToSha256   string `json:"to_sha256,omitempty" col-name:"To SHA256"`
+}
+
+type metadataChangeRow struct {
+	Change string `col-name:"Change"`
+	Field  string `col-name:"Field"`
+	From   string `col-name:"From"`
+	To     string `col-name:"To"`
+}
+
+// versionSnapshot is the information about a single version that takes part in the diff.
+type versionSnapshot struct {
+	appVersion *model.AppVersion
+	content    *model.VersionContent
+}
+
+func (dv *diffAppVersionsCommand) Run() error {
+	ctx, err := service.NewContext(*dv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	from, err := dv.getVersionSnapshot(ctx, dv.fromVersion)
+	if err != nil {
+		return err
+	}
+	to, err := dv.getVersionSnapshot(ctx, dv.toVersion)
+	if err != nil {
+		return err
+	}
+
+	diff := diffVersions(from, to)
+	diff.ApplicationKey = dv.applicationKey
+	diff.FromVersion = dv.fromVersion
+	diff.ToVersion = dv.toVersion
+
+	if dv.format == model.OutputFormatJson {
+		content, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	return printVersionDiff(diff)
+}
+
+func (dv *diffAppVersionsCommand) getVersionSnapshot(ctx service.Context, version string) (*versionSnapshot, error) {
+	appVersion, err := dv.versionService.GetAppVersion(ctx, dv.applicationKey, version)
+	if err != nil {
+		return nil, err
+	}
+	content, err := dv.versionService.GetAppVersionContent(ctx, dv.applicationKey, version)
+	if err != nil {
+		return nil, err
+	}
+	return &versionSnapshot{appVersion: appVersion, content: content}, nil
+}
+
+func diffVersions(from, to *versionSnapshot) *versionDiff {
+	diff := &versionDiff{
+		Properties: diffProperties(from.appVersion.Properties, to.appVersion.Properties),
+		Packages:   diffPackages(from.content.Releasables, to.content.Releasables),
+		Artifacts:  diffArtifacts(from.content.Releasables, to.content.Releasables),
+	}
+	if from.appVersion.Tag != to.appVersion.Tag {
+		diff.Tag = &tagChange{From: from.appVersion.Tag, To: to.appVersion.Tag}
+	}
+	return diff
+}
+
+func diffProperties(from, to map[string][]string) []propertyChange {
+	changes := []propertyChange{}
+	for _, key := range unionKeys(from, to) {
+		fromValues, inFrom := from[key]
+		toValues, inTo := to[key]
+		switch {
+		case !inFrom:
+			changes = append(changes, propertyChange{Change: changeAdded, Key: key, To: toValues})
+		case !inTo:
+			changes = append(changes, propertyChange{Change: changeRemoved, Key: key, From: fromValues})
+		case !reflect.DeepEqual(sortedCopy(fromValues), sortedCopy(toValues)):
+			changes = append(changes, propertyChange{Change: changeChanged, Key: key, From: fromValues, To: toValues})
+		}
+	}
+	return changes
+}
+
+// diffPackages compares packages by type and name. A package whose versions differ is reported as changed.
+func diffPackages(from, to []model.Releasable) []packageChange {
+	fromPackages := packageVersions(from)
+	toPackages := packageVersions(to)
+	changes := []packageChange{}
+	for _, key := range unionKeys(fromPackages, toPackages) {
+		fromVersion, inFrom := fromPackages[key]
+		toVersion, inTo := toPackages[key]
+		packageType, name, _ := strings.Cut(key, "/")
+		change := packageChange{Type: packageType, Name: name, FromVersion: fromVersion, ToVersion: toVersion}
+		switch {
+		case !inFrom:
+			change.Change = changeAdded
+		case !inTo:
+			change.Change = changeRemoved
+		case fromVersion != toVersion:
+			change.Change = changeChanged
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// packageVersions maps each package, in the form of type/name, to its versions.
+func packageVersions(releasables []model.Releasable) map[string]string {
+	versionsPerPackage := map[string][]string{}
+	for _, releasable := range releasables {
+		key := releasable.PackageType + "/" + releasable.Name
+		versionsPerPackage[key] = append(versionsPerPackage[key], releasable.Version)
+	}
+	result := make(map[string]string, len(versionsPerPackage))
+	for key, packageVersions := range versionsPerPackage {
+		result[key] = strings.Join(sortedCopy(packageVersions), ", ")
+	}
+	return result
+}
+
+// diffArtifacts compares artifacts by path. An artifact whose SHA256 differs is reported as changed.
+// A removed artifact with the same SHA256 as an added one is reported as moved to the path of the added artifact.
+func diffArtifacts(from, to []model.Releasable) []artifactChange {
+	fromArtifacts := artifactChecksums(from)
+	toArtifacts := artifactChecksums(to)
+	changes := []artifactChange{}
+	for _, path := range unionKeys(fromArtifacts, toArtifacts) {
+		fromSha256, inFrom := fromArtifacts[path]
+		toSha256, inTo := toArtifacts[path]
+		change := artifactChange{Path: path, FromSha256: fromSha256, ToSha256: toSha256}
+		switch {
+		case !inFrom:
+			change.Change = changeAdded
+		case !inTo:
+			change.Change = changeRemoved
+		case fromSha256 != toSha256:
+			change.Change = changeChanged
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return pairMovedArtifacts(changes)
+}
+
+// pairMovedArtifacts replaces each pair of a removed and an added artifact with the same SHA256 with a single move.
+// Removed artifacts are paired in path order, so that the result is stable when several artifacts share a checksum.
+func pairMovedArtifacts(changes []artifactChange) []artifactChange {
+	removedBySha256 := map[string][]int{}
+	for i, change := range changes {
+		if change.Change == changeRemoved && change.FromSha256 != "" {
+			sha256 := strings.ToLower(change.FromSha256)
+			removedBySha256[sha256] = append(removedBySha256[sha256], i)
+		}
+	}
+
+	moved := map[int]bool{}
+	for i, change := range changes {
+		if change.Change != changeAdded || change.ToSha256 == "" {
+			continue
+		}
+		sha256 := strings.ToLower(change.ToSha256)
+		removed := removedBySha256[sha256]
+		if len(removed) == 0 {
+			continue
+		}
+		removedBySha256[sha256] = removed[1:]
+		moved[removed[0]] = true
+		changes[i].Change = changeMoved
+		changes[i].FromPath = changes[removed[0]].Path
+		changes[i].FromSha256 = changes[removed[0]].FromSha256
+	}
+
+	paired := make([]artifactChange, 0, len(changes)-len(moved))
+	for i, change := range changes {
+		if !moved[i] {
+			paired = append(paired, change)
+		}
+	}
+	return paired
+}
+
+func artifactChecksums(releasables []model.Releasable) map[string]string {
+	checksums := map[string]string{}
+	for _, releasable := range releasables {
+		for _, artifact := range releasable.Artifacts {
+			checksums[artifact.Path] = artifact.Sha256
+		}
+	}
+	return checksums
+}
+
+// unionKeys returns the keys of both maps, sorted.
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, exists := a[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func printVersionDiff(diff *versionDiff) error {
+	var metadataRows []metadataChangeRow
+	if diff.Tag != nil {
+		metadataRows = append(metadataRows, metadataChangeRow{Change: changeChanged, Field: "tag", From: diff.Tag.From, To: diff.Tag.To})
+	}
+	for _, property := range diff.Properties {
+		metadataRows = append(metadataRows, metadataChangeRow{
+			Change: property.Change,
+			Field:  "property " + property.Key,
+			From:   strings.Join(property.From, ", "),
+			To:     strings.Join(property.To, ", "),
+		})
+	}
+
+	if err := coreutils.PrintTable(metadataRows, "Tag and Properties", "No tag or property changes.", false); err != nil {
+		return err
+	}
+	if err := coreutils.PrintTable(diff.Packages, "Packages", "No package changes.", false); err != nil {
+		return err
+	}
+	return coreutils.PrintTable(diff.Artifacts, "Artifacts", "No artifact changes.", false)
+}
+
+func (dv *diffAppVersionsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return dv.serverDetails, nil
+}
+
+func (dv *diffAppVersionsCommand) CommandName() string {
+	return commands.VersionDiff
+}
+
+func (dv *diffAppVersionsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 3 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	dv.applicationKey = ctx.Arguments[0]
+	dv.fromVersion = ctx.Arguments[1]
+	dv.toVersion = ctx.Arguments[2]
+
+	var err error
+	dv.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.OutputFormatValues)
+	if err != nil {
+		return err
+	}
+
+	dv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(dv)
+}
+
+func GetDiffAppVersionsCommand(appContext app.Context) components.Command {
+	cmd := &diffAppVersionsCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionDiff,
+		Description: "Compare the content, tag and properties of two application versions.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vdf"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "from-version",
+				Description: "The version to compare from, such as the version currently in production.",
+				Optional:    false,
+			},
+			{
+				Name:        "to-version",
+				Description: "The version to compare to, such as a release candidate.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionDiff),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,187 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffVersions(t *testing.T) {
+	from := &versionSnapshot{
+		appVersion: &model.AppVersion{
+			Version:    "1.0.0",
+			Tag:        "stable",
+			Properties: map[string][]string{"owner": {"team-a"}, "env": {"qa", "prod"}, "legacy": {"true"}},
+		},
+		content: &model.VersionContent{Releasables: []model.Releasable{
+			{
+				Name:        "frontend",
+				Version:     "2.0.0",
+				PackageType: "npm",
+				Artifacts:   []model.ReleasableArtifact{{Path: "npm-local/frontend/-/frontend-2.0.0.tgz", Sha256: "aaa"}},
+			},
+			{
+				Name:        "backend",
+				Version:     "3.1.0",
+				PackageType: "generic",
+				Artifacts:   []model.ReleasableArtifact{{Path: "generic-local/backend/backend.jar", Sha256: "bbb"}},
+			},
+			{
+				Name:        "docs",
+				Version:     "1.0.0",
+				PackageType: "generic",
+				Artifacts:   []model.ReleasableArtifact{{Path: "generic-local/docs/docs.zip", Sha256: "ccc"}},
+			},
+		}},
+	}
+	to := &versionSnapshot{
+		appVersion: &model.AppVersion{
+			Version:    "1.1.0",
+			Tag:        "candidate",
+			Properties: map[string][]string{"owner": {"team-b"}, "env": {"prod", "qa"}, "ticket": {"REL-1"}},
+		},
+		content: &model.VersionContent{Releasables: []model.Releasable{
+			{
+				Name:        "frontend",
+				Version:     "2.1.0",
+				PackageType: "npm",
+				Artifacts:   []model.ReleasableArtifact{{Path: "npm-local/frontend/-/frontend-2.1.0.tgz", Sha256: "ddd"}},
+			},
+			{
+				Name:        "backend",
+				Version:     "3.1.0",
+				PackageType: "generic",
+				Artifacts:   []model.ReleasableArtifact{{Path: "generic-local/backend/backend.jar", Sha256: "eee"}},
+			},
+			{
+				Name:        "worker",
+				Version:     "0.1.0",
+				PackageType: "docker",
+				Artifacts:   []model.ReleasableArtifact{{Path: "docker-local/worker/0.1.0/manifest.json", Sha256: "fff"}},
+			},
+		}},
+	}
+
+	diff := diffVersions(from, to)
+	assert.Equal(t, &versionDiff{
+		Tag: &tagChange{From: "stable", To: "candidate"},
+		Properties: []propertyChange{
+			{Change: changeRemoved, Key: "legacy", From: []string{"true"}},
+			{Change: changeChanged, Key: "owner", From: []string{"team-a"}, To: []string{"team-b"}},
+			{Change: changeAdded, Key: "ticket", To: []string{"REL-1"}},
+		},
+		Packages: []packageChange{
+			{Change: changeAdded, Type: "docker", Name: "worker", ToVersion: "0.1.0"},
+			{Change: changeRemoved, Type: "generic", Name: "docs", FromVersion: "1.0.0"},
+			{Change: changeChanged, Type: "npm", Name: "frontend", FromVersion: "2.0.0", ToVersion: "2.1.0"},
+		},
+		Artifacts: []artifactChange{
+			{Change: changeAdded, Path: "docker-local/worker/0.1.0/manifest.json", ToSha256: "fff"},
+			{Change: changeChanged, Path: "generic-local/backend/backend.jar", FromSha256: "bbb", ToSha256: "eee"},
+			{Change: changeRemoved, Path: "generic-local/docs/docs.zip", FromSha256: "ccc"},
+			{Change: changeRemoved, Path: "npm-local/frontend/-/frontend-2.0.0.tgz", FromSha256: "aaa"},
+			{Change: changeAdded, Path: "npm-local/frontend/-/frontend-2.1.0.tgz", ToSha256: "ddd"},
+		},
+	}, diff)
+}
+
+func TestDiffVersions_Identical(t *testing.T) {
+	snapshot := &versionSnapshot{
+		appVersion: &model.AppVersion{Version: "1.0.0", Tag: "stable"},
+		content:    testVersionContent,
+	}
+
+	diff := diffVersions(snapshot, snapshot)
+	assert.Equal(t, &versionDiff{
+		Properties: []propertyChange{},
+		Packages:   []packageChange{},
+		Artifacts:  []artifactChange{},
+	}, diff)
+}
+
+func TestDiffArtifacts_Moved(t *testing.T) {
+	from := []model.Releasable{{Name: "app", Artifacts: []model.ReleasableArtifact{
+		{Path: "repo/a/app.jar", Sha256: "aaa"},
+		{Path: "repo/b/copy-1.txt", Sha256: "bbb"},
+		{Path: "repo/b/copy-2.txt", Sha256: "bbb"},
+		{Path: "repo/c/old.txt", Sha256: "ccc"},
+	}}}
+	to := []model.Releasable{{Name: "app", Artifacts: []model.ReleasableArtifact{
+		{Path: "repo/a/renamed.jar", Sha256: "AAA"},
+		{Path: "repo/b/copy-3.txt", Sha256: "bbb"},
+		{Path: "repo/c/new.txt", Sha256: "ddd"},
+	}}}
+
+	assert.Equal(t, []artifactChange{
+		{Change: changeMoved, Path: "repo/a/renamed.jar", FromPath: "repo/a/app.jar", FromSha256: "aaa", ToSha256: "AAA"},
+		{Change: changeRemoved, Path: "repo/b/copy-2.txt", FromSha256: "bbb"},
+		{Change: changeMoved, Path: "repo/b/copy-3.txt", FromPath: "repo/b/copy-1.txt", FromSha256: "bbb", ToSha256: "bbb"},
+		{Change: changeAdded, Path: "repo/c/new.txt", ToSha256: "ddd"},
+		{Change: changeRemoved, Path: "repo/c/old.txt", FromSha256: "ccc"},
+	}, diffArtifacts(from, to))
+}
+
+func TestDiffAppVersionsCommand_Run(t *testing.T) {
+	for _, format := range model.OutputFormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			for _, version := range []string{"1.0.0", "1.1.0"} {
+				mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", version).
+					Return(&model.AppVersion{Version: version, Tag: "tag-" + version}, nil).Times(1)
+				mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", version).
+					Return(testVersionContent, nil).Times(1)
+			}
+
+			cmd := &diffAppVersionsCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				fromVersion:    "1.0.0",
+				toVersion:      "1.1.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDiffAppVersionsCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("get error")).Times(1)
+
+	cmd := &diffAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		fromVersion:    "1.0.0",
+		toVersion:      "1.1.0",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "get error")
+}
+
+func TestDiffAppVersionsCommand_InvalidFormat(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0", "1.1.0"}}
+	ctx.AddStringFlag(commands.FormatFlag, "csv")
+
+	cmd := &diffAppVersionsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --format: 'csv'. Allowed values: table and json")
+}
@@ -0,0 +1,326 @@
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/artifacts"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	defaultDownloadThreads = 3
+	partialFileSuffix      = ".part"
+
+	downloadDownloaded = "downloaded"
+	downloadResumed    = "resumed"
+	downloadSkipped    = "skipped"
+	downloadFailed     = "failed"
+)
+
+type downloadAppVersionCommand struct {
+	versionService  versions.VersionService
+	artifactService artifacts.ArtifactService
+	serverDetails   *coreConfig.ServerDetails
+	applicationKey  string
+	version         string
+	target          string
+	threads         int
+}
+
+type downloadResultRow struct {
+	Path    string `col-name:"Path"`
+	Result  string `col-name:"Result"`
+	Details string `col-name:"Details"`
+}
+
+func (dv *downloadAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*dv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, dv.versionService, dv.applicationKey, dv.version)
+	if err != nil {
+		return err
+	}
+
+	content, err := dv.versionService.GetAppVersionContent(ctx, dv.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	versionArtifacts, err := collectVersionArtifacts(content)
+	if err != nil {
+		return err
+	}
+	if len(versionArtifacts) == 0 {
+		log.Info("Version", version, "of application", dv.applicationKey, "has no artifacts to download.")
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Downloading %d artifact(s) of version %s to %s...", len(versionArtifacts), version, dv.target))
+	return reportDownloadResults(dv.downloadArtifacts(ctx, versionArtifacts))
+}
+
+// collectVersionArtifacts returns the artifacts of all the releasables, once per path.
+// Paths that are not local are rejected, so that they can be safely joined to a local directory.
+func collectVersionArtifacts(content *model.VersionContent) ([]model.ReleasableArtifact, error) {
+	var collected []model.ReleasableArtifact
+	seen := make(map[string]bool)
+	for _, releasable := range content.Releasables {
+		for _, artifact := range releasable.Artifacts {
+			artifact.Path = strings.TrimPrefix(artifact.Path, "/")
+			if seen[artifact.Path] {
+				continue
+			}
+			if !filepath.IsLocal(filepath.FromSlash(artifact.Path)) {
+				return nil, errorutils.CheckErrorf("invalid artifact path '%s': the path must be relative and stay within its repository", artifact.Path)
+			}
+			seen[artifact.Path] = true
+			collected = append(collected, artifact)
+		}
+	}
+	return collected, nil
+}
+
+// downloadArtifacts downloads the artifacts using up to dv.threads concurrent requests.
+// The returned results are in the same order as the artifacts.
+func (dv *downloadAppVersionCommand) downloadArtifacts(ctx service.Context, versionArtifacts []model.ReleasableArtifact) []downloadResultRow {
+	results := make([]downloadResultRow, len(versionArtifacts))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < dv.threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				artifact := versionArtifacts[index]
+				results[index] = downloadResultRow{Path: artifact.Path}
+				result, err := dv.downloadArtifact(ctx, artifact)
+				if err != nil {
+					results[index].Result = downloadFailed
+					results[index].Details = err.Error()
+					continue
+				}
+				results[index].Result = result
+			}
+		}()
+	}
+	for index := range versionArtifacts {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+// downloadArtifact downloads an artifact into a partial file next to its final location, and moves it to the final
+// location once its sha256 matches the checksum recorded in the version. If the partial file already exists from an
+// interrupted run, the download resumes from its end. An artifact whose final file already matches is skipped.
+func (dv *downloadAppVersionCommand) downloadArtifact(ctx service.Context, artifact model.ReleasableArtifact) (string, error) {
+	if artifact.Sha256 == "" {
+		return "", errorutils.CheckErrorf("the version has no sha256 checksum recorded for the artifact")
+	}
+
+	localPath := filepath.Join(dv.target, filepath.FromSlash(artifact.Path))
+	matches, err := fileMatchesSha256(localPath, artifact.Sha256)
+	if err != nil || matches {
+		return downloadSkipped, err
+	}
+	if err = fileutils.CreateDirIfNotExist(filepath.Dir(localPath)); err != nil {
+		return "", err
+	}
+
+	partialPath := localPath + partialFileSuffix
+	partialFile, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = partialFile.Close()
+	}()
+
+	// Hash the content downloaded by a previous run, so that the whole artifact can be verified after resuming.
+	hasher := sha256.New()
+	offset, err := io.Copy(hasher, partialFile)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if artifact.Size > 0 && offset > artifact.Size {
+		if err = restartPartialFile(partialFile, hasher); err != nil {
+			return "", err
+		}
+		offset = 0
+	}
+
+	// A partial file that already holds the whole artifact only needs to be verified.
+	result := downloadResumed
+	if artifact.Size == 0 || offset < artifact.Size {
+		result, err = dv.downloadToPartialFile(ctx, artifact.Path, partialFile, hasher, offset)
+		if err != nil {
+			return "", err
+		}
+	}
+	if err = partialFile.Close(); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+
+	actualSha256 := hex.EncodeToString(hasher.Sum(nil))
+	if !strings.EqualFold(actualSha256, artifact.Sha256) {
+		_ = os.Remove(partialPath)
+		return "", errorutils.CheckErrorf("checksum mismatch: expected sha256 %s, but got %s", artifact.Sha256, actualSha256)
+	}
+	if err = os.Rename(partialPath, localPath); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return result, nil
+}
+
+// downloadToPartialFile appends the artifact content starting at offset to the partial file.
+// If the server does not resume from offset, the partial file is restarted.
+func (dv *downloadAppVersionCommand) downloadToPartialFile(ctx service.Context, path string, partialFile *os.File, hasher hash.Hash, offset int64) (string, error) {
+	body, servedOffset, err := dv.artifactService.DownloadArtifact(ctx, path, offset)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+
+	result := downloadResumed
+	if offset == 0 || servedOffset != offset {
+		result = downloadDownloaded
+		if err = restartPartialFile(partialFile, hasher); err != nil {
+			return "", err
+		}
+	}
+	// The partial file is kept on failure, so that the next run resumes from where this one stopped.
+	if _, err = io.Copy(io.MultiWriter(partialFile, hasher), body); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return result, nil
+}
+
+func restartPartialFile(partialFile *os.File, hasher hash.Hash) error {
+	hasher.Reset()
+	if err := partialFile.Truncate(0); err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err := partialFile.Seek(0, io.SeekStart)
+	return errorutils.CheckError(err)
+}
+
+func fileMatchesSha256(filePath, expectedSha256 string) (bool, error) {
+	exists, err := fileutils.IsFileExists(filePath, false)
+	if err != nil || !exists {
+		return false, err
+	}
+	details, err := fileutils.GetFileDetails(filePath, true)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(details.Checksum.Sha256, expectedSha256), nil
+}
+
+func reportDownloadResults(results []downloadResultRow) error {
+	if err := coreutils.PrintTable(results, "Download Summary", "", false); err != nil {
+		return err
+	}
+	failed := 0
+	for _, result := range results {
+		if result.Result == downloadFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("%d of %d artifact(s) failed to download. Run the command again to resume", failed, len(results))
+	}
+	log.Info(fmt.Sprintf("%d artifact(s) downloaded and verified.", len(results)))
+	return nil
+}
+
+func (dv *downloadAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return dv.serverDetails, nil
+}
+
+func (dv *downloadAppVersionCommand) CommandName() string {
+	return commands.VersionDownload
+}
+
+func (dv *downloadAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	dv.applicationKey = ctx.Arguments[0]
+	dv.version = ctx.Arguments[1]
+
+	if err := utils.AssertValueProvided(ctx, commands.TargetFlag); err != nil {
+		return err
+	}
+	dv.target = ctx.GetStringFlagValue(commands.TargetFlag)
+
+	var err error
+	dv.threads, err = utils.ParseNonNegativeIntFlag(ctx, commands.ThreadsFlag, defaultDownloadThreads)
+	if err != nil {
+		return err
+	}
+	if dv.threads == 0 {
+		return errorutils.CheckErrorf("--%s must be greater than 0", commands.ThreadsFlag)
+	}
+
+	dv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(dv)
+}
+
+func GetDownloadAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &downloadAppVersionCommand{
+		versionService:  appContext.GetVersionService(),
+		artifactService: appContext.GetArtifactService(),
+	}
+	return components.Command{
+		Name:        commands.VersionDownload,
+		Description: "Download the artifacts of an application version and verify their checksums. Interrupted downloads are resumed when the command runs again.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vdl"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to download. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionDownload),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,215 @@
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockartifacts "github.com/jfrog/jfrog-cli-application/apptrust/service/artifacts/mocks"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testArtifactContent = "artifact content"
+
+func testSha256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTestDownloadCommand(t *testing.T, ctrl *gomock.Controller, artifacts []model.ReleasableArtifact) (*downloadAppVersionCommand, *mockartifacts.MockArtifactService) {
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.VersionContent{Releasables: []model.Releasable{{Name: "pkg", Artifacts: artifacts}}}, nil).Times(1)
+	mockArtifactService := mockartifacts.NewMockArtifactService(ctrl)
+
+	return &downloadAppVersionCommand{
+		versionService:  mockVersionService,
+		artifactService: mockArtifactService,
+		serverDetails:   &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:  "app-key",
+		version:         "1.0.0",
+		target:          t.TempDir(),
+		threads:         2,
+	}, mockArtifactService
+}
+
+func TestCollectVersionArtifacts(t *testing.T) {
+	content := &model.VersionContent{Releasables: []model.Releasable{
+		{Name: "a", Artifacts: []model.ReleasableArtifact{{Path: "/repo/a/file.txt"}, {Path: "repo/shared.txt"}}},
+		{Name: "b", Artifacts: []model.ReleasableArtifact{{Path: "repo/shared.txt"}, {Path: "repo/b/file.txt"}}},
+	}}
+
+	collected, err := collectVersionArtifacts(content)
+	require.NoError(t, err)
+	paths := []string{}
+	for _, artifact := range collected {
+		paths = append(paths, artifact.Path)
+	}
+	assert.Equal(t, []string{"repo/a/file.txt", "repo/shared.txt", "repo/b/file.txt"}, paths)
+}
+
+func TestCollectVersionArtifacts_UnsafePath(t *testing.T) {
+	content := &model.VersionContent{Releasables: []model.Releasable{
+		{Name: "a", Artifacts: []model.ReleasableArtifact{{Path: "repo/../../outside.txt"}}},
+	}}
+
+	_, err := collectVersionArtifacts(content)
+	assert.EqualError(t, err, "invalid artifact path 'repo/../../outside.txt': the path must be relative and stay within its repository")
+}
+
+func TestDownloadAppVersionCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cmd, mockArtifactService := newTestDownloadCommand(t, ctrl, []model.ReleasableArtifact{
+		{Path: "repo/a/file.txt", Sha256: testSha256(testArtifactContent), Size: int64(len(testArtifactContent))},
+		{Path: "repo/b/file.txt", Sha256: testSha256("other content")},
+	})
+	mockArtifactService.EXPECT().DownloadArtifact(gomock.Any(), "repo/a/file.txt", int64(0)).
+		Return(io.NopCloser(strings.NewReader(testArtifactContent)), int64(0), nil).Times(1)
+	mockArtifactService.EXPECT().DownloadArtifact(gomock.Any(), "repo/b/file.txt", int64(0)).
+		Return(io.NopCloser(strings.NewReader("other content")), int64(0), nil).Times(1)
+
+	err := cmd.Run()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(cmd.target, "repo", "a", "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, testArtifactContent, string(content))
+	assert.FileExists(t, filepath.Join(cmd.target, "repo", "b", "file.txt"))
+	assert.NoFileExists(t, filepath.Join(cmd.target, "repo", "a", "file.txt"+partialFileSuffix))
+}
+
+func TestDownloadAppVersionCommand_Run_SkipsVerifiedFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cmd, _ := newTestDownloadCommand(t, ctrl, []model.ReleasableArtifact{
+		{Path: "repo/file.txt", Sha256: testSha256(testArtifactContent)},
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(cmd.target, "repo"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(cmd.target, "repo", "file.txt"), []byte(testArtifactContent), 0644))
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestDownloadAppVersionCommand_Run_Resume(t *testing.T) {
+	tests := []struct {
+		name         string
+		servedOffset int64
+		body         string
+	}{
+		{name: "range honored", servedOffset: 8, body: testArtifactContent[8:]},
+		{name: "range ignored", servedOffset: 0, body: testArtifactContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			artifact := model.ReleasableArtifact{Path: "repo/file.txt", Sha256: testSha256(testArtifactContent), Size: int64(len(testArtifactContent))}
+			cmd, mockArtifactService := newTestDownloadCommand(t, ctrl, []model.ReleasableArtifact{artifact})
+			require.NoError(t, os.MkdirAll(filepath.Join(cmd.target, "repo"), 0755))
+			partialPath := filepath.Join(cmd.target, "repo", "file.txt"+partialFileSuffix)
+			require.NoError(t, os.WriteFile(partialPath, []byte(testArtifactContent[:8]), 0644))
+
+			mockArtifactService.EXPECT().DownloadArtifact(gomock.Any(), "repo/file.txt", int64(8)).
+				Return(io.NopCloser(strings.NewReader(tt.body)), tt.servedOffset, nil).Times(1)
+
+			err := cmd.Run()
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join(cmd.target, "repo", "file.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, testArtifactContent, string(content))
+			assert.NoFileExists(t, partialPath)
+		})
+	}
+}
+
+func TestDownloadAppVersionCommand_Run_ChecksumMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cmd, mockArtifactService := newTestDownloadCommand(t, ctrl, []model.ReleasableArtifact{
+		{Path: "repo/file.txt", Sha256: testSha256(testArtifactContent)},
+	})
+	mockArtifactService.EXPECT().DownloadArtifact(gomock.Any(), "repo/file.txt", int64(0)).
+		Return(io.NopCloser(strings.NewReader("tampered content")), int64(0), nil).Times(1)
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "1 of 1 artifact(s) failed to download. Run the command again to resume")
+	assert.NoFileExists(t, filepath.Join(cmd.target, "repo", "file.txt"))
+	assert.NoFileExists(t, filepath.Join(cmd.target, "repo", "file.txt"+partialFileSuffix))
+}
+
+func TestDownloadAppVersionCommand_Run_DownloadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cmd, mockArtifactService := newTestDownloadCommand(t, ctrl, []model.ReleasableArtifact{
+		{Path: "repo/file.txt", Sha256: testSha256(testArtifactContent)},
+		{Path: "repo/no-checksum.txt"},
+	})
+	mockArtifactService.EXPECT().DownloadArtifact(gomock.Any(), "repo/file.txt", int64(0)).
+		Return(nil, int64(0), errors.New("download error")).Times(1)
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "2 of 2 artifact(s) failed to download. Run the command again to resume")
+}
+
+func TestDownloadAppVersionCommand_PrepareValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctxSetup      func(*components.Context)
+		expectedError string
+	}{
+		{
+			name:          "missing target",
+			ctxSetup:      func(ctx *components.Context) {},
+			expectedError: "the --target option is mandatory",
+		},
+		{
+			name: "zero threads",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.TargetFlag, "./out")
+				ctx.AddStringFlag(commands.ThreadsFlag, "0")
+			},
+			expectedError: "--threads must be greater than 0",
+		},
+		{
+			name: "invalid threads",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.TargetFlag, "./out")
+				ctx.AddStringFlag(commands.ThreadsFlag, "many")
+			},
+			expectedError: "invalid value for --threads",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+			tt.ctxSetup(ctx)
+
+			cmd := &downloadAppVersionCommand{}
+			err := cmd.prepareAndRunCommand(ctx)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package version
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+// buildEvidenceEnvelope wraps the predicate in an in-toto statement whose subject is the application version,
+// identified by the digest of its content, and returns it as an unsigned DSSE envelope.
+func buildEvidenceEnvelope(applicationKey, version string, content *model.VersionContent, predicateType string, predicate map[string]interface{}) (*model.DsseEnvelope, error) {
+	subject := model.InTotoSubject{
+		Name:   applicationKey + "/" + version,
+		Digest: map[string]string{model.InTotoDigestSha256: versionContentDigest(content)},
+	}
+	statement := &model.InTotoStatement{
+		Type:          model.InTotoStatementType,
+		Subject:       []model.InTotoSubject{subject},
+		PredicateType: predicateType,
+		Predicate:     predicate,
+	}
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &model.DsseEnvelope{
+		PayloadType: model.InTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []model.DsseSignature{},
+	}, nil
+}
+
+// versionContentDigest returns the sha256 of a manifest of the version artifacts, which lists the sha256 and path of
+// each artifact once, sorted by path, in the format of sha256sum. The digest changes if any artifact of the version changes.
+func versionContentDigest(content *model.VersionContent) string {
+	checksums := make(map[string]string)
+	for _, releasable := range content.Releasables {
+		for _, artifact := range releasable.Artifacts {
+			checksums[strings.TrimPrefix(artifact.Path, "/")] = strings.ToLower(artifact.Sha256)
+		}
+	}
+	paths := make([]string, 0, len(checksums))
+	for path := range checksums {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	hasher := sha256.New()
+	for _, path := range paths {
+		_, _ = fmt.Fprintf(hasher, "%s  %s\n", checksums[path], path)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// loadPrivateKey loads an unencrypted PEM private key. RSA, ECDSA and Ed25519 keys are supported.
+func loadPrivateKey(keyPath string) (crypto.Signer, error) {
+	content, err := fileutils.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errorutils.CheckErrorf("no PEM-encoded private key found in %s", keyPath)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, errorutils.CheckErrorf("unsupported private key type '%s' in %s. Only unencrypted RSA, ECDSA and Ed25519 keys are supported", block.Type, keyPath)
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the private key in %s: %s", keyPath, err.Error())
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errorutils.CheckErrorf("unsupported private key in %s", keyPath)
+	}
+	return signer, nil
+}
+
+// signEnvelope adds a signature over the DSSE pre-authentication encoding of the envelope payload.
+func signEnvelope(envelope *model.DsseEnvelope, signer crypto.Signer, keyId string) error {
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+
+	message := preAuthEncoding(envelope.PayloadType, payload)
+	var signature []byte
+	if _, isEd25519 := signer.(ed25519.PrivateKey); isEd25519 {
+		signature, err = signer.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		signature, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+
+	envelope.Signatures = append(envelope.Signatures, model.DsseSignature{
+		KeyId: keyId,
+		Sig:   base64.StdEncoding.EncodeToString(signature),
+	})
+	return nil
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding, which binds the payload type to the payload.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
@@ -0,0 +1,164 @@
+package version
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvidenceContent = &model.VersionContent{Releasables: []model.Releasable{
+	{Name: "b", Artifacts: []model.ReleasableArtifact{{Path: "repo/b.txt", Sha256: testSha256("b")}}},
+	{Name: "a", Artifacts: []model.ReleasableArtifact{{Path: "/repo/a.txt", Sha256: strings.ToUpper(testSha256("a"))}}},
+}}
+
+func TestBuildEvidenceEnvelope(t *testing.T) {
+	envelope, err := buildEvidenceEnvelope("app-key", "1.0.0", testEvidenceContent, "https://example.com/test-report/v1", map[string]interface{}{"passed": true})
+	require.NoError(t, err)
+	assert.Equal(t, model.InTotoPayloadType, envelope.PayloadType)
+	assert.Empty(t, envelope.Signatures)
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	require.NoError(t, err)
+	statement := &model.InTotoStatement{}
+	require.NoError(t, json.Unmarshal(payload, statement))
+	assert.Equal(t, &model.InTotoStatement{
+		Type: model.InTotoStatementType,
+		Subject: []model.InTotoSubject{{
+			Name:   "app-key/1.0.0",
+			Digest: map[string]string{"sha256": versionContentDigest(testEvidenceContent)},
+		}},
+		PredicateType: "https://example.com/test-report/v1",
+		Predicate:     map[string]interface{}{"passed": true},
+	}, statement)
+}
+
+func TestVersionContentDigest(t *testing.T) {
+	manifest := testSha256("a") + "  repo/a.txt\n" + testSha256("b") + "  repo/b.txt\n"
+	assert.Equal(t, testSha256(manifest), versionContentDigest(testEvidenceContent))
+	assert.Equal(t, testSha256(""), versionContentDigest(&model.VersionContent{}))
+
+	changed := &model.VersionContent{Releasables: []model.Releasable{
+		{Name: "a", Artifacts: []model.ReleasableArtifact{{Path: "repo/a.txt", Sha256: testSha256("a")}, {Path: "repo/b.txt", Sha256: testSha256("c")}}},
+	}}
+	assert.NotEqual(t, versionContentDigest(testEvidenceContent), versionContentDigest(changed))
+}
+
+func TestSignEnvelope(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		key    crypto.Signer
+		verify func(message, signature []byte) bool
+	}{
+		{
+			name: "rsa",
+			key:  rsaKey,
+			verify: func(message, signature []byte) bool {
+				digest := sha256.Sum256(message)
+				return rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest[:], signature) == nil
+			},
+		},
+		{
+			name: "ecdsa",
+			key:  ecdsaKey,
+			verify: func(message, signature []byte) bool {
+				digest := sha256.Sum256(message)
+				return ecdsa.VerifyASN1(&ecdsaKey.PublicKey, digest[:], signature)
+			},
+		},
+		{
+			name: "ed25519",
+			key:  ed25519Key,
+			verify: func(message, signature []byte) bool {
+				return ed25519.Verify(ed25519Key.Public().(ed25519.PublicKey), message, signature)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope, err := buildEvidenceEnvelope("app-key", "1.0.0", testEvidenceContent, "https://example.com/test-report/v1", map[string]interface{}{})
+			require.NoError(t, err)
+
+			require.NoError(t, signEnvelope(envelope, tt.key, "ci-key"))
+			require.Len(t, envelope.Signatures, 1)
+			assert.Equal(t, "ci-key", envelope.Signatures[0].KeyId)
+
+			payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+			require.NoError(t, err)
+			signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+			require.NoError(t, err)
+			assert.True(t, tt.verify(preAuthEncoding(envelope.PayloadType, payload), signature))
+		})
+	}
+}
+
+func TestPreAuthEncoding(t *testing.T) {
+	assert.Equal(t, "DSSEv1 29 http://example.com/HelloWorld 11 hello world",
+		string(preAuthEncoding("http://example.com/HelloWorld", []byte("hello world"))))
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	ecDer, err := x509.MarshalECPrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		block       *pem.Block
+		expectedErr string
+	}{
+		{name: "pkcs8", block: &pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}},
+		{name: "ec", block: &pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDer}},
+		{name: "pkcs1", block: &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}},
+		{name: "encrypted", block: &pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: pkcs8}, expectedErr: "unsupported private key type 'ENCRYPTED PRIVATE KEY'"},
+		{name: "corrupted", block: &pem.Block{Type: "PRIVATE KEY", Bytes: []byte("corrupted")}, expectedErr: "failed to parse the private key"},
+		{name: "not pem", expectedErr: "no PEM-encoded private key found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte("not a key")
+			if tt.block != nil {
+				content = pem.EncodeToMemory(tt.block)
+			}
+			keyPath := filepath.Join(t.TempDir(), "key.pem")
+			require.NoError(t, os.WriteFile(keyPath, content, 0o600))
+
+			signer, err := loadPrivateKey(keyPath)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, signer)
+		})
+	}
+}
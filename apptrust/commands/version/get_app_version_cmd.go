@@ -0,0 +1,93 @@
+package version
+
+import (
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type getAppVersionCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+}
+
+func (gv *getAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*gv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	appVersion, err := gv.versionService.GetAppVersion(ctx, gv.applicationKey, gv.version)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(appVersion, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+func (gv *getAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return gv.serverDetails, nil
+}
+
+func (gv *getAppVersionCommand) CommandName() string {
+	return commands.VersionGet
+}
+
+func (gv *getAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	gv.applicationKey = ctx.Arguments[0]
+	gv.version = ctx.Arguments[1]
+
+	var err error
+	gv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(gv)
+}
+
+func GetGetAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &getAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionGet,
+		Description: "Get the details and status of an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vg"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The name of the version to get.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionGet),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
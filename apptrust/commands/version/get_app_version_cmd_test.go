@@ -0,0 +1,73 @@
+package version
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAppVersionCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "QA"}, nil).Times(1)
+
+	cmd := &getAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestGetAppVersionCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("get error")).Times(1)
+
+	cmd := &getAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "get error")
+}
+
+func TestGetAppVersionCommand_WrongNumberOfArguments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	app := cli.NewApp()
+	set := flag.NewFlagSet("test", 0)
+	ctx := cli.NewContext(app, set, nil)
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	cmd := &getAppVersionCommand{
+		versionService: mockVersionService,
+	}
+
+	context, err := components.ConvertContext(ctx)
+	assert.NoError(t, err)
+
+	err = cmd.prepareAndRunCommand(context)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Wrong number of arguments")
+}
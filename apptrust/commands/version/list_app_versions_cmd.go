@@ -0,0 +1,214 @@
+package version
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const dateLayout = "2006-01-02"
+
+type listAppVersionsCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	filters        *versionFilters
+	sortBy         string
+	sortOrder      string
+}
+
+// versionFilters holds the criteria a version must match to be listed. Empty criteria match every version.
+type versionFilters struct {
+	stage         string
+	tag           string
+	releaseStatus string
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+func (lv *listAppVersionsCommand) Run() error {
+	ctx, err := service.NewContext(*lv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	appVersions, err := utils.ListAllAppVersions(ctx, lv.versionService, lv.applicationKey)
+	if err != nil {
+		return err
+	}
+
+	appVersions = lv.filters.apply(appVersions)
+	sortAppVersions(appVersions, lv.sortBy, lv.sortOrder)
+
+	content, err := json.MarshalIndent(appVersions, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+func (vf *versionFilters) apply(appVersions []model.AppVersion) []model.AppVersion {
+	result := []model.AppVersion{}
+	for _, appVersion := range appVersions {
+		if vf.matches(appVersion) {
+			result = append(result, appVersion)
+		}
+	}
+	return result
+}
+
+func (vf *versionFilters) matches(appVersion model.AppVersion) bool {
+	if vf.stage != "" && !strings.EqualFold(appVersion.CurrentStage, vf.stage) {
+		return false
+	}
+	if vf.tag != "" && appVersion.Tag != vf.tag {
+		return false
+	}
+	if vf.releaseStatus != "" && !strings.EqualFold(appVersion.ReleaseStatus, vf.releaseStatus) {
+		return false
+	}
+	if vf.createdAfter.IsZero() && vf.createdBefore.IsZero() {
+		return true
+	}
+
+	created, err := time.Parse(time.RFC3339, appVersion.Created)
+	if err != nil {
+		return false
+	}
+	if !vf.createdAfter.IsZero() && !created.After(vf.createdAfter) {
+		return false
+	}
+	return vf.createdBefore.IsZero() || created.Before(vf.createdBefore)
+}
+
+// sortAppVersions sorts the versions by creation time or by semantic version precedence.
+// Versions with equal keys keep their original order.
+func sortAppVersions(appVersions []model.AppVersion, sortBy, sortOrder string) {
+	sort.SliceStable(appVersions, func(i, j int) bool {
+		var result int
+		if sortBy == model.VersionSortBySemver {
+			result = utils.CompareVersions(appVersions[i].Version, appVersions[j].Version)
+		} else {
+			result = utils.CompareCreated(appVersions[i].Created, appVersions[j].Created)
+		}
+		if sortOrder == model.SortOrderDesc {
+			return result > 0
+		}
+		return result < 0
+	})
+}
+
+func (lv *listAppVersionsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return lv.serverDetails, nil
+}
+
+func (lv *listAppVersionsCommand) CommandName() string {
+	return commands.VersionList
+}
+
+func (lv *listAppVersionsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	lv.applicationKey = ctx.Arguments[0]
+
+	var err error
+	lv.filters, err = buildVersionFilters(ctx)
+	if err != nil {
+		return err
+	}
+
+	lv.sortBy, err = utils.ValidateEnumFlag(commands.SortByFlag, ctx.GetStringFlagValue(commands.SortByFlag),
+		model.VersionSortByCreated, model.VersionSortByValues)
+	if err != nil {
+		return err
+	}
+
+	lv.sortOrder, err = utils.ValidateEnumFlag(commands.SortOrderFlag, ctx.GetStringFlagValue(commands.SortOrderFlag),
+		model.SortOrderDesc, model.SortOrderValues)
+	if err != nil {
+		return err
+	}
+
+	lv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(lv)
+}
+
+func buildVersionFilters(ctx *components.Context) (*versionFilters, error) {
+	filters := &versionFilters{
+		stage:         ctx.GetStringFlagValue(commands.StageVarsFlag),
+		tag:           ctx.GetStringFlagValue(commands.TagFlag),
+		releaseStatus: ctx.GetStringFlagValue(commands.ReleaseStatusFlag),
+	}
+
+	var err error
+	filters.createdAfter, err = parseTimeFlag(ctx, commands.CreatedAfterFlag)
+	if err != nil {
+		return nil, err
+	}
+	filters.createdBefore, err = parseTimeFlag(ctx, commands.CreatedBeforeFlag)
+	if err != nil {
+		return nil, err
+	}
+	if !filters.createdAfter.IsZero() && !filters.createdBefore.IsZero() && !filters.createdAfter.Before(filters.createdBefore) {
+		return nil, errorutils.CheckErrorf("--%s must be earlier than --%s", commands.CreatedAfterFlag, commands.CreatedBeforeFlag)
+	}
+	return filters, nil
+}
+
+// parseTimeFlag parses a flag holding either a date or an RFC 3339 timestamp.
+// Returns the zero time if the flag is not set.
+func parseTimeFlag(ctx *components.Context, flagName string) (time.Time, error) {
+	value := ctx.GetStringFlagValue(flagName)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(dateLayout, value); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a date (%s) or a timestamp (%s)",
+		flagName, value, dateLayout, time.RFC3339)
+}
+
+func GetListAppVersionsCommand(appContext app.Context) components.Command {
+	cmd := &listAppVersionsCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionList,
+		Description: "List the versions of an application.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vl"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionList),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,173 @@
+package version
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testAppVersions = []model.AppVersion{
+	{Version: "1.10.0", CurrentStage: "QA", Tag: "rc", Created: "2025-03-01T10:00:00Z"},
+	{Version: "1.2.0", CurrentStage: "PROD", ReleaseStatus: "RELEASED", Created: "2025-01-01T10:00:00Z"},
+	{Version: "1.9.0", CurrentStage: "QA", Created: "2025-02-01T10:00:00Z"},
+}
+
+func TestBuildVersionFilters(t *testing.T) {
+	tests := []struct {
+		name             string
+		ctxSetup         func(*components.Context)
+		expectedVersions []string
+		expectedErr      string
+	}{
+		{
+			name:             "no filters",
+			ctxSetup:         func(ctx *components.Context) {},
+			expectedVersions: []string{"1.10.0", "1.2.0", "1.9.0"},
+		},
+		{
+			name: "stage",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.StageVarsFlag, "qa")
+			},
+			expectedVersions: []string{"1.10.0", "1.9.0"},
+		},
+		{
+			name: "tag",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.TagFlag, "rc")
+			},
+			expectedVersions: []string{"1.10.0"},
+		},
+		{
+			name: "release status",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.ReleaseStatusFlag, "released")
+			},
+			expectedVersions: []string{"1.2.0"},
+		},
+		{
+			name: "created range",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.CreatedAfterFlag, "2025-01-15")
+				ctx.AddStringFlag(commands.CreatedBeforeFlag, "2025-02-15T00:00:00Z")
+			},
+			expectedVersions: []string{"1.9.0"},
+		},
+		{
+			name: "invalid date",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.CreatedAfterFlag, "yesterday")
+			},
+			expectedErr: "invalid value for --created-after: 'yesterday'. Expected a date (2006-01-02) or a timestamp (2006-01-02T15:04:05Z07:00)",
+		},
+		{
+			name: "empty range",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.CreatedAfterFlag, "2025-02-01")
+				ctx.AddStringFlag(commands.CreatedBeforeFlag, "2025-01-01")
+			},
+			expectedErr: "--created-after must be earlier than --created-before",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+
+			filters, err := buildVersionFilters(ctx)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var actualVersions []string
+			for _, appVersion := range filters.apply(testAppVersions) {
+				actualVersions = append(actualVersions, appVersion.Version)
+			}
+			assert.Equal(t, tt.expectedVersions, actualVersions)
+		})
+	}
+}
+
+func TestSortAppVersions(t *testing.T) {
+	tests := []struct {
+		sortBy   string
+		order    string
+		expected []string
+	}{
+		{model.VersionSortByCreated, model.SortOrderDesc, []string{"1.10.0", "1.9.0", "1.2.0"}},
+		{model.VersionSortByCreated, model.SortOrderAsc, []string{"1.2.0", "1.9.0", "1.10.0"}},
+		{model.VersionSortBySemver, model.SortOrderDesc, []string{"1.10.0", "1.9.0", "1.2.0"}},
+		{model.VersionSortBySemver, model.SortOrderAsc, []string{"1.2.0", "1.9.0", "1.10.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sortBy+" "+tt.order, func(t *testing.T) {
+			appVersions := append([]model.AppVersion{}, testAppVersions...)
+			sortAppVersions(appVersions, tt.sortBy, tt.order)
+
+			var actual []string
+			for _, appVersion := range appVersions {
+				actual = append(actual, appVersion.Version)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestSortAppVersions_CreatedAsInstants(t *testing.T) {
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.1.0", Created: "2025-01-01T10:00:00.500Z"},
+		{Version: "1.2.0", Created: "2025-01-01T11:30:00+02:00"},
+		{Version: "1.3.0", Created: "not a time"},
+	}
+
+	sortAppVersions(appVersions, model.VersionSortByCreated, model.SortOrderDesc)
+
+	var actual []string
+	for _, appVersion := range appVersions {
+		actual = append(actual, appVersion.Version)
+	}
+	assert.Equal(t, []string{"1.1.0", "1.0.0", "1.2.0", "1.3.0"}, actual)
+}
+
+func TestListAppVersionsCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", map[string]string{"offset": "0", "limit": "100"}).
+		Return(&model.ListAppVersionsResponse{Versions: testAppVersions, Total: 3}, nil).Times(1)
+
+	cmd := &listAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		filters:        &versionFilters{createdBefore: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		sortBy:         model.VersionSortByCreated,
+		sortOrder:      model.SortOrderDesc,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestListAppVersionsCommand_InvalidSortBy(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.SortByFlag, "name")
+
+	cmd := &listAppVersionsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --sort-by: 'name'. Allowed values: created and semver")
+}
@@ -0,0 +1,136 @@
+package version
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type listVersionEvidenceCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	format         string
+}
+
+type evidenceRow struct {
+	Id            string `col-name:"ID"`
+	PredicateType string `col-name:"Predicate Type"`
+	Signed        string `col-name:"Signed"`
+	KeyId         string `col-name:"Key ID"`
+	CreatedBy     string `col-name:"Created By"`
+	Created       string `col-name:"Created"`
+}
+
+func (le *listVersionEvidenceCommand) Run() error {
+	ctx, err := service.NewContext(*le.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, le.versionService, le.applicationKey, le.version)
+	if err != nil {
+		return err
+	}
+
+	response, err := le.versionService.ListAppVersionEvidence(ctx, le.applicationKey, version)
+	if err != nil {
+		return err
+	}
+
+	if le.format == model.OutputFormatJson {
+		evidence := response.Evidence
+		if evidence == nil {
+			evidence = []model.Evidence{}
+		}
+		content, err := json.MarshalIndent(evidence, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+
+	rows := make([]evidenceRow, len(response.Evidence))
+	for i, evidence := range response.Evidence {
+		rows[i] = evidenceRow{
+			Id:            evidence.Id,
+			PredicateType: evidence.PredicateType,
+			Signed:        strconv.FormatBool(evidence.Signed),
+			KeyId:         evidence.KeyId,
+			CreatedBy:     evidence.CreatedBy,
+			Created:       evidence.Created,
+		}
+	}
+	return coreutils.PrintTable(rows, "Evidence", "No evidence is attached to the version.", false)
+}
+
+func (le *listVersionEvidenceCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return le.serverDetails, nil
+}
+
+func (le *listVersionEvidenceCommand) CommandName() string {
+	return commands.VersionEvidenceList
+}
+
+func (le *listVersionEvidenceCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	le.applicationKey = ctx.Arguments[0]
+	le.version = ctx.Arguments[1]
+
+	var err error
+	le.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.OutputFormatValues)
+	if err != nil {
+		return err
+	}
+
+	le.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(le)
+}
+
+func GetListVersionEvidenceCommand(appContext app.Context) components.Command {
+	cmd := &listVersionEvidenceCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionEvidenceList,
+		Description: "List the evidence attached to an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vel"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version whose evidence to list. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionEvidenceList),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,58 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListVersionEvidenceCommand_Run(t *testing.T) {
+	for _, format := range model.OutputFormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().ListAppVersionEvidence(gomock.Any(), "app-key", "1.0.0").
+				Return(&model.ListEvidenceResponse{Evidence: []model.Evidence{
+					{Id: "ev-1", PredicateType: "https://slsa.dev/provenance/v1", Signed: true, KeyId: "ci-key"},
+					{Id: "ev-2", PredicateType: "https://example.com/test-report/v1"},
+				}}, nil).Times(1)
+
+			cmd := &listVersionEvidenceCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestListVersionEvidenceCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersionEvidence(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("list error")).Times(1)
+
+	cmd := &listVersionEvidenceCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "list error")
+}
@@ -3,6 +3,8 @@ package version
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"time"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
@@ -24,6 +26,8 @@ type promoteAppVersionCommand struct {
 	version        string
 	requestPayload *model.PromoteAppVersionRequest
 	sync           bool
+	wait           bool
+	timeout        time.Duration
 }
 
 func (pv *promoteAppVersionCommand) Run() error {
@@ -32,7 +36,16 @@ func (pv *promoteAppVersionCommand) Run() error {
 		return err
 	}
 
-	return pv.versionService.PromoteAppVersion(ctx, pv.applicationKey, pv.version, pv.requestPayload, pv.sync)
+	version, err := resolveVersion(ctx, pv.versionService, pv.applicationKey, pv.version)
+	if err != nil {
+		return err
+	}
+
+	if err = pv.versionService.PromoteAppVersion(ctx, pv.applicationKey, version, pv.requestPayload, pv.sync); err != nil || !pv.wait {
+		return err
+	}
+	return newVersionWaiter(pv.versionService, pv.timeout).wait(ctx, pv.applicationKey, version,
+		&waitCondition{event: waitForPromoted, stage: pv.requestPayload.Stage})
 }
 
 func (pv *promoteAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -52,8 +65,17 @@ func (pv *promoteAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 	pv.applicationKey = ctx.Arguments[0]
 	pv.version = ctx.Arguments[1]
 
-	// Extract sync flag value
-	pv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag)
+	// Extract sync and wait flag values. Waiting implies an asynchronous request.
+	pv.wait = ctx.GetBoolFlagValue(commands.WaitFlag)
+	pv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag) && !pv.wait
+	if pv.wait && ctx.GetBoolFlagValue(commands.DryRunFlag) {
+		return errorutils.CheckErrorf("--%s cannot be used with --%s", commands.WaitFlag, commands.DryRunFlag)
+	}
+	timeout, err := parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+	pv.timeout = timeout
 
 	serverDetails, err := utils.ServerDetailsByFlags(ctx)
 	if err != nil {
@@ -68,8 +90,12 @@ func (pv *promoteAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 }
 
 func (pv *promoteAppVersionCommand) buildRequestPayload(ctx *components.Context) (*model.PromoteAppVersionRequest, error) {
-	stage := ctx.Arguments[2]
+	return buildPromoteRequest(ctx, ctx.Arguments[2])
+}
 
+// buildPromoteRequest builds a request to promote a version to the stage, according to the promotion flags.
+// Used by both promote and promote-chain commands
+func buildPromoteRequest(ctx *components.Context, stage string) (*model.PromoteAppVersionRequest, error) {
 	promotionType, includedRepos, excludedRepos, err := BuildPromotionParams(ctx)
 	if err != nil {
 		return nil, err
@@ -112,7 +138,7 @@ func GetPromoteAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The version to promote.",
+				Description: "The version to promote. " + versionSelectorsDescription,
 				Optional:    false,
 			},
 			{
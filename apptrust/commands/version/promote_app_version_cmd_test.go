@@ -3,11 +3,14 @@ package version
 import (
 	"errors"
 	"testing"
+	"time"
 
 	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
 	"go.uber.org/mock/gomock"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -107,3 +110,72 @@ func TestPromoteAppVersionCommand_Run_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "service error occurred")
 }
+
+func TestPromoteAppVersionCommand_Run_Wait(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	requestPayload := &model.PromoteAppVersionRequest{Stage: "QA"}
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0", requestPayload, false).
+			Return(nil).Times(1),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "QA"}, nil).Times(1),
+	)
+
+	cmd := &promoteAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		requestPayload: requestPayload,
+		wait:           true,
+		timeout:        time.Minute,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestPromoteAppVersionCommand_WaitWithDryRun(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0", "QA"}}
+	ctx.AddBoolFlag(commands.WaitFlag, true)
+	ctx.AddBoolFlag(commands.DryRunFlag, true)
+
+	cmd := &promoteAppVersionCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "--wait cannot be used with --dry-run")
+}
+
+func TestPromoteAppVersionCommand_Run_VersionSelector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	requestPayload := &model.PromoteAppVersionRequest{Stage: "PROD"}
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{
+			Versions: []model.AppVersion{
+				{Version: "1.1.0", CurrentStage: "QA", Created: "2025-02-01T10:00:00Z"},
+				{Version: "1.2.0", CurrentStage: "DEV", Created: "2025-03-01T10:00:00Z"},
+			},
+			Total: 2,
+		}, nil).Times(1)
+	mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.1.0", requestPayload, true).
+		Return(nil).Times(1)
+
+	cmd := &promoteAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "latest@QA",
+		requestPayload: requestPayload,
+		sync:           true,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
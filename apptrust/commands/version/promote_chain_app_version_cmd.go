@@ -0,0 +1,248 @@
+package version
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	stepPromoted   = "promoted"
+	stepSkipped    = "skipped"
+	stepFailed     = "failed"
+	stepNotStarted = "not started"
+)
+
+type promoteChainAppVersionCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	stages         []string
+	gates          []stageGate
+	requestPayload *model.PromoteAppVersionRequest
+	timeout        time.Duration
+}
+
+// stageGate is a property a version must have before it is promoted to a stage.
+// If the value is empty, the property only needs to exist.
+type stageGate struct {
+	stage string
+	key   string
+	value string
+}
+
+type promotionStep struct {
+	Stage   string `col-name:"Stage"`
+	Result  string `col-name:"Result"`
+	Details string `col-name:"Details"`
+}
+
+func (pc *promoteChainAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*pc.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, pc.versionService, pc.applicationKey, pc.version)
+	if err != nil {
+		return err
+	}
+
+	steps, err := pc.promoteChain(ctx, version)
+	if printErr := coreutils.PrintTable(steps, "Promotion Chain", "", false); printErr != nil && err == nil {
+		return printErr
+	}
+	return err
+}
+
+// promoteChain promotes the version to each stage in turn, and stops at the first stage that fails.
+// The stages up to and including the current stage of the version are skipped, so that the version is never promoted backward.
+// Returns the result of every stage, including the stages that were not started.
+func (pc *promoteChainAppVersionCommand) promoteChain(ctx service.Context, version string) ([]promotionStep, error) {
+	steps := make([]promotionStep, len(pc.stages))
+	for i, stage := range pc.stages {
+		steps[i] = promotionStep{Stage: stage, Result: stepNotStarted}
+	}
+
+	appVersion, err := pc.versionService.GetAppVersion(ctx, pc.applicationKey, version)
+	if err != nil {
+		return steps, err
+	}
+	start := slices.IndexFunc(pc.stages, func(stage string) bool { return strings.EqualFold(stage, appVersion.CurrentStage) }) + 1
+	if start > 0 {
+		log.Info("Version", version, "is already in stage", appVersion.CurrentStage+". Skipping the stages up to and including it.")
+	}
+	for i := 0; i < start; i++ {
+		steps[i].Result = stepSkipped
+	}
+
+	waiter := newVersionWaiter(pc.versionService, pc.timeout)
+	for i := start; i < len(pc.stages); i++ {
+		stage := pc.stages[i]
+		if err = pc.promoteToStage(ctx, waiter, version, stage); err != nil {
+			steps[i].Result = stepFailed
+			steps[i].Details = err.Error()
+			return steps, errorutils.CheckErrorf("the promotion chain of version '%s' stopped at stage %s after %d of %d stage(s): %s",
+				version, stage, i, len(pc.stages), err.Error())
+		}
+		steps[i].Result = stepPromoted
+	}
+	return steps, nil
+}
+
+func (pc *promoteChainAppVersionCommand) promoteToStage(ctx service.Context, waiter *versionWaiter, version, stage string) error {
+	appVersion, err := pc.versionService.GetAppVersion(ctx, pc.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	if err = checkStageGates(appVersion, stage, pc.gates); err != nil {
+		return err
+	}
+
+	request := *pc.requestPayload
+	request.Stage = stage
+	log.Info("Promoting version", version, "of application", pc.applicationKey, "to", stage+"...")
+	if err = pc.versionService.PromoteAppVersion(ctx, pc.applicationKey, version, &request, false); err != nil {
+		return err
+	}
+	return waiter.wait(ctx, pc.applicationKey, version, &waitCondition{event: waitForPromoted, stage: stage})
+}
+
+// checkStageGates verifies that the version has the properties required by the gates of the stage.
+func checkStageGates(appVersion *model.AppVersion, stage string, gates []stageGate) error {
+	for _, gate := range gates {
+		if !strings.EqualFold(gate.stage, stage) {
+			continue
+		}
+		values, exists := appVersion.Properties[gate.key]
+		if !exists {
+			return errorutils.CheckErrorf("the gate of stage %s requires the '%s' property", stage, gate.key)
+		}
+		if gate.value != "" && !slices.Contains(values, gate.value) {
+			return errorutils.CheckErrorf("the gate of stage %s requires the '%s' property to be '%s', but it is '%s'",
+				stage, gate.key, gate.value, strings.Join(values, ","))
+		}
+	}
+	return nil
+}
+
+func (pc *promoteChainAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *promoteChainAppVersionCommand) CommandName() string {
+	return commands.VersionPromoteChain
+}
+
+func (pc *promoteChainAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	pc.applicationKey = ctx.Arguments[0]
+	pc.version = ctx.Arguments[1]
+
+	var err error
+	pc.stages, err = parseStagesFlag(ctx)
+	if err != nil {
+		return err
+	}
+	pc.gates, err = parseGatesFlag(ctx.GetStringFlagValue(commands.GatesFlag), pc.stages)
+	if err != nil {
+		return err
+	}
+	pc.timeout, err = parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+
+	pc.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	pc.requestPayload, err = buildPromoteRequest(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(pc)
+}
+
+// parseStagesFlag parses the comma-separated list of stages to promote to, in order.
+func parseStagesFlag(ctx *components.Context) ([]string, error) {
+	if err := utils.AssertValueProvided(ctx, commands.StagesFlag); err != nil {
+		return nil, err
+	}
+
+	var stages []string
+	for _, stage := range strings.Split(ctx.GetStringFlagValue(commands.StagesFlag), ",") {
+		stage = strings.TrimSpace(stage)
+		if stage == "" {
+			return nil, errorutils.CheckErrorf("invalid value for --%s: stage names must not be empty", commands.StagesFlag)
+		}
+		if slices.ContainsFunc(stages, func(s string) bool { return strings.EqualFold(s, stage) }) {
+			return nil, errorutils.CheckErrorf("invalid value for --%s: stage %s appears more than once", commands.StagesFlag, stage)
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// parseGatesFlag parses gates in the form of "stage:key[=value];stage:key[=value]...".
+// Every gate must belong to one of the stages of the chain.
+func parseGatesFlag(value string, stages []string) ([]stageGate, error) {
+	var gates []stageGate
+	for _, entry := range utils.ParseSliceFlag(value) {
+		stage, property, found := strings.Cut(entry, utils.PartSeparator)
+		key, propertyValue, _ := strings.Cut(property, "=")
+		stage, key = strings.TrimSpace(stage), strings.TrimSpace(key)
+		if !found || stage == "" || key == "" {
+			return nil, errorutils.CheckErrorf("invalid gate '%s'. Expected the form of 'stage:key[=value]'", entry)
+		}
+		if !slices.ContainsFunc(stages, func(s string) bool { return strings.EqualFold(s, stage) }) {
+			return nil, errorutils.CheckErrorf("invalid gate '%s'. Stage %s is not one of the stages in --%s", entry, stage, commands.StagesFlag)
+		}
+		gates = append(gates, stageGate{stage: stage, key: key, value: strings.TrimSpace(propertyValue)})
+	}
+	return gates, nil
+}
+
+func GetPromoteChainAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &promoteChainAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionPromoteChain,
+		Description: "Promote an application version through several stages in order, waiting for each promotion to complete. Stages up to and including the current stage of the version are skipped.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vpc"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to promote. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionPromoteChain),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,252 @@
+package version
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseStagesFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    []string
+		expectedErr string
+	}{
+		{name: "single stage", value: "DEV", expected: []string{"DEV"}},
+		{name: "several stages", value: "DEV, QA,STAGING", expected: []string{"DEV", "QA", "STAGING"}},
+		{name: "missing", expectedErr: "the --stages option is mandatory"},
+		{name: "empty stage", value: "DEV,,QA", expectedErr: "invalid value for --stages: stage names must not be empty"},
+		{name: "duplicate stage", value: "DEV,QA,dev", expectedErr: "invalid value for --stages: stage dev appears more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			if tt.value != "" {
+				ctx.AddStringFlag(commands.StagesFlag, tt.value)
+			}
+			stages, err := parseStagesFlag(ctx)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, stages)
+		})
+	}
+}
+
+func TestParseGatesFlag(t *testing.T) {
+	stages := []string{"DEV", "QA", "PROD"}
+	tests := []struct {
+		name        string
+		value       string
+		expected    []stageGate
+		expectedErr string
+	}{
+		{name: "no gates"},
+		{
+			name:  "several gates",
+			value: "QA:tests=passed; PROD:change-ticket",
+			expected: []stageGate{
+				{stage: "QA", key: "tests", value: "passed"},
+				{stage: "PROD", key: "change-ticket"},
+			},
+		},
+		{name: "missing stage", value: "tests=passed", expectedErr: "invalid gate 'tests=passed'. Expected the form of 'stage:key[=value]'"},
+		{name: "missing key", value: "QA:=passed", expectedErr: "invalid gate 'QA:=passed'. Expected the form of 'stage:key[=value]'"},
+		{name: "unknown stage", value: "STAGING:tests", expectedErr: "invalid gate 'STAGING:tests'. Stage STAGING is not one of the stages in --stages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gates, err := parseGatesFlag(tt.value, stages)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, gates)
+		})
+	}
+}
+
+func TestCheckStageGates(t *testing.T) {
+	gates := []stageGate{
+		{stage: "QA", key: "tests", value: "passed"},
+		{stage: "PROD", key: "change-ticket"},
+	}
+
+	appVersion := &model.AppVersion{Properties: map[string][]string{"tests": {"passed"}}}
+	assert.NoError(t, checkStageGates(appVersion, "DEV", gates))
+	assert.NoError(t, checkStageGates(appVersion, "qa", gates))
+	assert.EqualError(t, checkStageGates(appVersion, "PROD", gates), "the gate of stage PROD requires the 'change-ticket' property")
+
+	appVersion = &model.AppVersion{Properties: map[string][]string{"tests": {"failed"}}}
+	assert.EqualError(t, checkStageGates(appVersion, "QA", gates), "the gate of stage QA requires the 'tests' property to be 'passed', but it is 'failed'")
+}
+
+func TestPromoteChainAppVersionCommand_PromoteChain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		// DEV: the version is already there
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "DEV"}, nil),
+		// QA
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "DEV"}, nil),
+		mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0",
+			&model.PromoteAppVersionRequest{Stage: "QA", CommonPromoteAppVersion: model.CommonPromoteAppVersion{PromotionType: "copy"}}, false).
+			Return(nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "QA"}, nil),
+		// STAGING
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "QA"}, nil),
+		mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0",
+			&model.PromoteAppVersionRequest{Stage: "STAGING", CommonPromoteAppVersion: model.CommonPromoteAppVersion{PromotionType: "copy"}}, false).
+			Return(nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "STAGING"}, nil),
+	)
+
+	cmd := &promoteChainAppVersionCommand{
+		versionService: mockVersionService,
+		applicationKey: "app-key",
+		stages:         []string{"DEV", "QA", "STAGING"},
+		requestPayload: &model.PromoteAppVersionRequest{CommonPromoteAppVersion: model.CommonPromoteAppVersion{PromotionType: "copy"}},
+		timeout:        time.Minute,
+	}
+
+	steps, err := cmd.promoteChain(nil, "1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, []promotionStep{
+		{Stage: "DEV", Result: stepSkipped},
+		{Stage: "QA", Result: stepPromoted},
+		{Stage: "STAGING", Result: stepPromoted},
+	}, steps)
+}
+
+func TestPromoteChainAppVersionCommand_PromoteChain_SkipsEarlierStages(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentStage   string
+		promotedStages []string
+		expected       []string
+	}{
+		{name: "in last stage", currentStage: "prod", expected: []string{stepSkipped, stepSkipped, stepSkipped}},
+		{name: "in middle stage", currentStage: "QA", promotedStages: []string{"PROD"}, expected: []string{stepSkipped, stepSkipped, stepPromoted}},
+		{name: "in stage outside the chain", currentStage: "SANDBOX", promotedStages: []string{"DEV", "QA", "PROD"}, expected: []string{stepPromoted, stepPromoted, stepPromoted}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			calls := []any{
+				mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+					Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: tt.currentStage}, nil),
+			}
+			for _, stage := range tt.promotedStages {
+				calls = append(calls,
+					mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+						Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED"}, nil),
+					mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0", &model.PromoteAppVersionRequest{Stage: stage}, false).
+						Return(nil),
+					mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+						Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: stage}, nil),
+				)
+			}
+			gomock.InOrder(calls...)
+
+			cmd := &promoteChainAppVersionCommand{
+				versionService: mockVersionService,
+				applicationKey: "app-key",
+				stages:         []string{"DEV", "QA", "PROD"},
+				requestPayload: &model.PromoteAppVersionRequest{},
+				timeout:        time.Minute,
+			}
+
+			steps, err := cmd.promoteChain(nil, "1.0.0")
+			require.NoError(t, err)
+			results := []string{}
+			for _, step := range steps {
+				results = append(results, step.Result)
+			}
+			assert.Equal(t, tt.expected, results)
+		})
+	}
+}
+
+func TestPromoteChainAppVersionCommand_PromoteChain_StopsAtFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED"}, nil).Times(2),
+		mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0", gomock.Any(), false).
+			Return(nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "DEV"}, nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "DEV"}, nil),
+		mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), "app-key", "1.0.0", gomock.Any(), false).
+			Return(errors.New("promote error")),
+	)
+
+	cmd := &promoteChainAppVersionCommand{
+		versionService: mockVersionService,
+		applicationKey: "app-key",
+		stages:         []string{"DEV", "QA", "PROD"},
+		requestPayload: &model.PromoteAppVersionRequest{},
+		timeout:        time.Minute,
+	}
+
+	steps, err := cmd.promoteChain(nil, "1.0.0")
+	assert.EqualError(t, err, "the promotion chain of version '1.0.0' stopped at stage QA after 1 of 3 stage(s): promote error")
+	assert.Equal(t, []promotionStep{
+		{Stage: "DEV", Result: stepPromoted},
+		{Stage: "QA", Result: stepFailed, Details: "promote error"},
+		{Stage: "PROD", Result: stepNotStarted},
+	}, steps)
+}
+
+func TestPromoteChainAppVersionCommand_PromoteChain_GateNotMet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED"}, nil).Times(2)
+
+	cmd := &promoteChainAppVersionCommand{
+		versionService: mockVersionService,
+		applicationKey: "app-key",
+		stages:         []string{"QA"},
+		gates:          []stageGate{{stage: "QA", key: "tests", value: "passed"}},
+		requestPayload: &model.PromoteAppVersionRequest{},
+		timeout:        time.Minute,
+	}
+
+	steps, err := cmd.promoteChain(nil, "1.0.0")
+	assert.EqualError(t, err, "the promotion chain of version '1.0.0' stopped at stage QA after 0 of 1 stage(s): the gate of stage QA requires the 'tests' property")
+	assert.Equal(t, []promotionStep{
+		{Stage: "QA", Result: stepFailed, Details: "the gate of stage QA requires the 'tests' property"},
+	}, steps)
+}
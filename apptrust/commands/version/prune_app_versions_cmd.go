@@ -0,0 +1,297 @@
+package version
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	defaultPruneThreads = 3
+	daySuffix           = "d"
+
+	pruneDeleted = "deleted"
+	pruneFailed  = "failed"
+)
+
+type pruneAppVersionsCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	rules          *pruneRules
+	dryRun         bool
+	force          bool
+	threads        int
+}
+
+// pruneRules decides which versions are deleted. A version is pruned only if it matches every rule.
+type pruneRules struct {
+	keepLast      int
+	createdBefore time.Time
+	onlyDrafts    bool
+	excludeStages []string
+}
+
+type pruneCandidateRow struct {
+	Version string `col-name:"Version"`
+	Stage   string `col-name:"Stage"`
+	Status  string `col-name:"Status"`
+	Created string `col-name:"Created"`
+}
+
+type pruneResultRow struct {
+	Version string `col-name:"Version"`
+	Result  string `col-name:"Result"`
+	Details string `col-name:"Details"`
+}
+
+func (pv *pruneAppVersionsCommand) Run() error {
+	ctx, err := service.NewContext(*pv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	appVersions, err := utils.ListAllAppVersions(ctx, pv.versionService, pv.applicationKey)
+	if err != nil {
+		return err
+	}
+
+	candidates := pv.rules.selectCandidates(appVersions)
+	if len(candidates) == 0 {
+		log.Info("No versions of application", pv.applicationKey, "match the prune rules.")
+		return nil
+	}
+	if err = printPruneCandidates(candidates); err != nil {
+		return err
+	}
+
+	if pv.dryRun {
+		log.Info(fmt.Sprintf("Dry run: %d version(s) would be deleted.", len(candidates)))
+		return nil
+	}
+	if !pv.force && !coreutils.AskYesNo(fmt.Sprintf("Are you sure you want to delete the %d version(s) listed above?", len(candidates)), false) {
+		log.Info("Pruning canceled.")
+		return nil
+	}
+
+	return reportPruneResults(pv.deleteVersions(ctx, candidates))
+}
+
+// selectCandidates returns the versions to prune, most recently created first.
+// The keepLast most recently created versions are never pruned. Versions whose creation time is unknown are never
+// pruned either, and are not counted as one of the keepLast versions.
+func (pr *pruneRules) selectCandidates(appVersions []model.AppVersion) []model.AppVersion {
+	var dated []model.AppVersion
+	for _, appVersion := range appVersions {
+		if _, err := time.Parse(time.RFC3339, appVersion.Created); err == nil {
+			dated = append(dated, appVersion)
+		}
+	}
+	sortAppVersions(dated, model.VersionSortByCreated, model.SortOrderDesc)
+
+	candidates := []model.AppVersion{}
+	for i, appVersion := range dated {
+		if i >= pr.keepLast && pr.matches(appVersion) {
+			candidates = append(candidates, appVersion)
+		}
+	}
+	return candidates
+}
+
+func (pr *pruneRules) matches(appVersion model.AppVersion) bool {
+	if pr.onlyDrafts && !strings.EqualFold(appVersion.Status, model.VersionStatusDraft) {
+		return false
+	}
+	if appVersion.CurrentStage != "" && slices.ContainsFunc(pr.excludeStages, func(stage string) bool {
+		return strings.EqualFold(stage, appVersion.CurrentStage)
+	}) {
+		return false
+	}
+	if pr.createdBefore.IsZero() {
+		return true
+	}
+	created, err := time.Parse(time.RFC3339, appVersion.Created)
+	return err == nil && created.Before(pr.createdBefore)
+}
+
+func printPruneCandidates(candidates []model.AppVersion) error {
+	rows := make([]pruneCandidateRow, len(candidates))
+	for i, appVersion := range candidates {
+		rows[i] = pruneCandidateRow{
+			Version: appVersion.Version,
+			Stage:   appVersion.CurrentStage,
+			Status:  appVersion.Status,
+			Created: appVersion.Created,
+		}
+	}
+	return coreutils.PrintTable(rows, "Prune Candidates", "", false)
+}
+
+// deleteVersions deletes the versions using up to pv.threads concurrent requests.
+// The returned results are in the same order as the versions.
+func (pv *pruneAppVersionsCommand) deleteVersions(ctx service.Context, appVersions []model.AppVersion) []pruneResultRow {
+	results := make([]pruneResultRow, len(appVersions))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < pv.threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				version := appVersions[index].Version
+				results[index] = pruneResultRow{Version: version, Result: pruneDeleted}
+				if err := pv.versionService.DeleteAppVersion(ctx, pv.applicationKey, version); err != nil {
+					results[index].Result = pruneFailed
+					results[index].Details = err.Error()
+				}
+			}
+		}()
+	}
+	for index := range appVersions {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+func reportPruneResults(results []pruneResultRow) error {
+	if err := coreutils.PrintTable(results, "Prune Summary", "", false); err != nil {
+		return err
+	}
+	failed := 0
+	for _, result := range results {
+		if result.Result == pruneFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("%d of %d version(s) failed to be deleted", failed, len(results))
+	}
+	log.Info(fmt.Sprintf("%d version(s) deleted.", len(results)))
+	return nil
+}
+
+func (pv *pruneAppVersionsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return pv.serverDetails, nil
+}
+
+func (pv *pruneAppVersionsCommand) CommandName() string {
+	return commands.VersionPrune
+}
+
+func (pv *pruneAppVersionsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	pv.applicationKey = ctx.Arguments[0]
+	pv.dryRun = ctx.GetBoolFlagValue(commands.DryRunFlag)
+	pv.force = ctx.GetBoolFlagValue(commands.ForceFlag)
+
+	var err error
+	pv.rules, err = buildPruneRules(ctx)
+	if err != nil {
+		return err
+	}
+	pv.threads, err = utils.ParseNonNegativeIntFlag(ctx, commands.ThreadsFlag, defaultPruneThreads)
+	if err != nil {
+		return err
+	}
+	if pv.threads == 0 {
+		return errorutils.CheckErrorf("--%s must be greater than 0", commands.ThreadsFlag)
+	}
+
+	pv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(pv)
+}
+
+// buildPruneRules requires at least one retention rule, so that a bare command never deletes every version.
+func buildPruneRules(ctx *components.Context) (*pruneRules, error) {
+	if !ctx.IsFlagSet(commands.KeepLastFlag) && ctx.GetStringFlagValue(commands.OlderThanFlag) == "" &&
+		!ctx.GetBoolFlagValue(commands.OnlyDraftsFlag) {
+		return nil, errorutils.CheckErrorf("at least one of --%s, --%s and --%s must be provided",
+			commands.KeepLastFlag, commands.OlderThanFlag, commands.OnlyDraftsFlag)
+	}
+
+	rules := &pruneRules{
+		onlyDrafts:    ctx.GetBoolFlagValue(commands.OnlyDraftsFlag),
+		excludeStages: utils.ParseSliceFlag(ctx.GetStringFlagValue(commands.ExcludeStagesFlag)),
+	}
+
+	var err error
+	rules.keepLast, err = utils.ParseNonNegativeIntFlag(ctx, commands.KeepLastFlag, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Keeping the last 0 versions is no retention rule at all, and would delete every version.
+	if ctx.IsFlagSet(commands.KeepLastFlag) && rules.keepLast == 0 {
+		return nil, errorutils.CheckErrorf("--%s must be greater than 0", commands.KeepLastFlag)
+	}
+	if value := ctx.GetStringFlagValue(commands.OlderThanFlag); value != "" {
+		age, err := parseAge(value)
+		if err != nil {
+			return nil, err
+		}
+		rules.createdBefore = time.Now().Add(-age)
+	}
+	return rules, nil
+}
+
+// parseAge parses a positive duration, which may also be given in days, such as 30d.
+func parseAge(value string) (time.Duration, error) {
+	var age time.Duration
+	if days, found := strings.CutSuffix(value, daySuffix); found {
+		if count, err := strconv.Atoi(days); err == nil {
+			age = time.Duration(count) * 24 * time.Hour
+		}
+	} else if duration, err := time.ParseDuration(value); err == nil {
+		age = duration
+	}
+	if age <= 0 {
+		return 0, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a positive duration, such as 30d, 12h or 90m",
+			commands.OlderThanFlag, value)
+	}
+	return age, nil
+}
+
+func GetPruneAppVersionsCommand(appContext app.Context) components.Command {
+	cmd := &pruneAppVersionsCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionPrune,
+		Description: "Delete the versions of an application that match retention rules, such as old drafts.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vpr"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionPrune),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,246 @@
+package version
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var testPruneVersions = []model.AppVersion{
+	{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "PROD", Created: "2025-01-01T10:00:00Z"},
+	{Version: "1.1.0", Status: "DRAFT", Created: "2025-02-01T10:00:00Z"},
+	{Version: "1.2.0", Status: "COMPLETED", CurrentStage: "QA", Created: "2025-03-01T10:00:00Z"},
+	{Version: "1.3.0", Status: "DRAFT", Created: "2025-04-01T10:00:00Z"},
+	{Version: "1.4.0", Status: "COMPLETED", Created: "2025-05-01T10:00:00Z"},
+}
+
+func TestPruneRules_SelectCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    *pruneRules
+		expected []string
+	}{
+		{
+			name:     "keep last",
+			rules:    &pruneRules{keepLast: 2},
+			expected: []string{"1.2.0", "1.1.0", "1.0.0"},
+		},
+		{
+			name:     "keep more than exist",
+			rules:    &pruneRules{keepLast: 10},
+			expected: []string{},
+		},
+		{
+			name:     "older than",
+			rules:    &pruneRules{createdBefore: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+			expected: []string{"1.1.0", "1.0.0"},
+		},
+		{
+			name:     "only drafts",
+			rules:    &pruneRules{onlyDrafts: true},
+			expected: []string{"1.3.0", "1.1.0"},
+		},
+		{
+			name:     "exclude stages",
+			rules:    &pruneRules{keepLast: 1, excludeStages: []string{"prod", "QA"}},
+			expected: []string{"1.3.0", "1.1.0"},
+		},
+		{
+			name:     "all rules",
+			rules:    &pruneRules{keepLast: 2, createdBefore: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), onlyDrafts: true},
+			expected: []string{"1.1.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := tt.rules.selectCandidates(testPruneVersions)
+			versions := []string{}
+			for _, candidate := range candidates {
+				versions = append(versions, candidate.Version)
+			}
+			assert.Equal(t, tt.expected, versions)
+		})
+	}
+}
+
+func TestPruneRules_SelectCandidates_KeepLastByCreationInstant(t *testing.T) {
+	// Compared as strings, 1.2.0 would be the newest and 1.1.0 the oldest, but as instants 1.2.0 is the oldest.
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", Created: "2025-03-01T10:00:00Z"},
+		{Version: "1.1.0", Created: "2025-03-01T10:00:00.500Z"},
+		{Version: "1.2.0", Created: "2025-03-01T11:00:00+02:00"},
+	}
+
+	candidates := (&pruneRules{keepLast: 2}).selectCandidates(appVersions)
+	versions := []string{}
+	for _, candidate := range candidates {
+		versions = append(versions, candidate.Version)
+	}
+	assert.Equal(t, []string{"1.2.0"}, versions)
+}
+
+func TestPruneRules_SelectCandidates_UnknownCreationTime(t *testing.T) {
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.1.0", Created: ""},
+		{Version: "1.2.0", Created: "2025-03-01T10:00:00Z"},
+		{Version: "1.3.0", Created: "not a time"},
+	}
+	tests := []struct {
+		name     string
+		rules    *pruneRules
+		expected []string
+	}{
+		{name: "keep last", rules: &pruneRules{keepLast: 1}, expected: []string{"1.0.0"}},
+		{name: "older than", rules: &pruneRules{createdBefore: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}, expected: []string{"1.2.0", "1.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := tt.rules.selectCandidates(appVersions)
+			versions := []string{}
+			for _, candidate := range candidates {
+				versions = append(versions, candidate.Version)
+			}
+			assert.Equal(t, tt.expected, versions)
+		})
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		value       string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{value: "30d", expected: 30 * 24 * time.Hour},
+		{value: "12h", expected: 12 * time.Hour},
+		{value: "90m", expected: 90 * time.Minute},
+		{value: "0d", expectedErr: true},
+		{value: "-1h", expectedErr: true},
+		{value: "xd", expectedErr: true},
+		{value: "month", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			age, err := parseAge(tt.value)
+			if tt.expectedErr {
+				assert.EqualError(t, err, "invalid value for --older-than: '"+tt.value+"'. Expected a positive duration, such as 30d, 12h or 90m")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, age)
+		})
+	}
+}
+
+func TestPruneAppVersionsCommand_RulesRequired(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.ExcludeStagesFlag, "PROD")
+
+	cmd := &pruneAppVersionsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "at least one of --keep-last, --older-than and --only-drafts must be provided")
+}
+
+func TestPruneAppVersionsCommand_ZeroKeepLast(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.KeepLastFlag, "0")
+	ctx.AddBoolFlag(commands.ForceFlag, true)
+
+	cmd := &pruneAppVersionsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "--keep-last must be greater than 0")
+}
+
+func TestPruneAppVersionsCommand_InvalidKeepLast(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key"}}
+	ctx.AddStringFlag(commands.KeepLastFlag, "-1")
+
+	cmd := &pruneAppVersionsCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --keep-last: '-1'. Expected a non-negative integer")
+}
+
+func TestPruneAppVersionsCommand_Run_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{Versions: testPruneVersions, Total: len(testPruneVersions)}, nil).Times(1)
+	mockVersionService.EXPECT().DeleteAppVersion(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	cmd := &pruneAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		rules:          &pruneRules{onlyDrafts: true},
+		dryRun:         true,
+		threads:        defaultPruneThreads,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestPruneAppVersionsCommand_Run(t *testing.T) {
+	tests := []struct {
+		name          string
+		failedVersion string
+		expectedErr   string
+	}{
+		{
+			name: "all versions deleted",
+		},
+		{
+			name:          "one version fails",
+			failedVersion: "1.1.0",
+			expectedErr:   "1 of 3 version(s) failed to be deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+				Return(&model.ListAppVersionsResponse{Versions: testPruneVersions, Total: len(testPruneVersions)}, nil).Times(1)
+			for _, version := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+				var err error
+				if version == tt.failedVersion {
+					err = errors.New("delete error")
+				}
+				mockVersionService.EXPECT().DeleteAppVersion(gomock.Any(), "app-key", version).Return(err).Times(1)
+			}
+
+			cmd := &pruneAppVersionsCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				rules:          &pruneRules{keepLast: 2},
+				force:          true,
+				threads:        2,
+			}
+
+			err := cmd.Run()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
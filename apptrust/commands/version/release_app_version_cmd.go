@@ -3,6 +3,8 @@ package version
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"time"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
@@ -24,6 +26,8 @@ type releaseAppVersionCommand struct {
 	version        string
 	requestPayload *model.ReleaseAppVersionRequest
 	sync           bool
+	wait           bool
+	timeout        time.Duration
 }
 
 func (rv *releaseAppVersionCommand) Run() error {
@@ -32,7 +36,16 @@ func (rv *releaseAppVersionCommand) Run() error {
 		return err
 	}
 
-	return rv.versionService.ReleaseAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	version, err := resolveVersion(ctx, rv.versionService, rv.applicationKey, rv.version)
+	if err != nil {
+		return err
+	}
+
+	if err = rv.versionService.ReleaseAppVersion(ctx, rv.applicationKey, version, rv.requestPayload, rv.sync); err != nil || !rv.wait {
+		return err
+	}
+	return newVersionWaiter(rv.versionService, rv.timeout).wait(ctx, rv.applicationKey, version,
+		&waitCondition{event: waitForReleased})
 }
 
 func (rv *releaseAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -52,8 +65,14 @@ func (rv *releaseAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 	rv.applicationKey = ctx.Arguments[0]
 	rv.version = ctx.Arguments[1]
 
-	// Extract sync flag value
-	rv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag)
+	// Extract sync and wait flag values. Waiting implies an asynchronous request.
+	rv.wait = ctx.GetBoolFlagValue(commands.WaitFlag)
+	rv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag) && !rv.wait
+	timeout, err := parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+	rv.timeout = timeout
 
 	serverDetails, err := utils.ServerDetailsByFlags(ctx)
 	if err != nil {
@@ -109,7 +128,7 @@ func GetReleaseAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The version to release.",
+				Description: "The version to release. " + versionSelectorsDescription,
 				Optional:    false,
 			},
 		},
@@ -3,6 +3,10 @@ package version
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
@@ -14,16 +18,21 @@ import (
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type rollbackAppVersionCommand struct {
-	versionService versions.VersionService
-	serverDetails  *coreConfig.ServerDetails
-	applicationKey string
-	version        string
-	requestPayload *model.RollbackAppVersionRequest
-	fromStage      string
-	sync           bool
+	versionService  versions.VersionService
+	serverDetails   *coreConfig.ServerDetails
+	applicationKey  string
+	version         string
+	requestPayload  *model.RollbackAppVersionRequest
+	fromStage       string
+	sync            bool
+	wait            bool
+	timeout         time.Duration
+	restorePrevious bool
 }
 
 func (rv *rollbackAppVersionCommand) Run() error {
@@ -32,7 +41,145 @@ func (rv *rollbackAppVersionCommand) Run() error {
 		return err
 	}
 
-	return rv.versionService.RollbackAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	version, err := resolveVersion(ctx, rv.versionService, rv.applicationKey, rv.version)
+	if err != nil {
+		return err
+	}
+
+	var previous *model.AppVersion
+	if rv.restorePrevious {
+		previous, err = rv.findPreviousVersion(ctx, version)
+		if err != nil {
+			return err
+		}
+		log.Info("Version", previous.Version, "was promoted to stage", rv.fromStage, "before version", version+".")
+	}
+
+	response, err := rv.versionService.RollbackAppVersion(ctx, rv.applicationKey, version, rv.requestPayload, rv.sync)
+	if err != nil {
+		return err
+	}
+	log.Output(rollbackSummary(rv.applicationKey, version, rv.fromStage, rv.sync, response))
+
+	if rv.wait {
+		err = newVersionWaiter(rv.versionService, rv.timeout).wait(ctx, rv.applicationKey, version,
+			&waitCondition{event: waitForRolledBack, stage: rv.fromStage})
+		if err != nil {
+			return err
+		}
+		log.Output(rollbackSummary(rv.applicationKey, version, rv.fromStage, true, nil))
+	}
+	if previous == nil {
+		return nil
+	}
+	return rv.confirmRestoredVersion(ctx, version, previous.Version)
+}
+
+// rollbackSummary describes the outcome of a rollback. An asynchronous rollback is only submitted, so its response does
+// not describe the outcome yet. The response of a completed rollback is nil when the server did not return a response body.
+func rollbackSummary(applicationKey, version, fromStage string, completed bool, response *model.RollbackAppVersionResponse) string {
+	switch {
+	case !completed:
+		return fmt.Sprintf("Submitted the rollback of version %s of application %s from stage %s.", version, applicationKey, fromStage)
+	case response == nil:
+		return fmt.Sprintf("Rolled back version %s of application %s from stage %s.", version, applicationKey, fromStage)
+	case response.RollbackToStage == "":
+		return fmt.Sprintf("Rolled back version %s of application %s from stage %s. The version is no longer in any stage.",
+			response.Version, response.ApplicationKey, response.RollbackFromStage)
+	default:
+		return fmt.Sprintf("Rolled back version %s of application %s from stage %s to stage %s.",
+			response.Version, response.ApplicationKey, response.RollbackFromStage, response.RollbackToStage)
+	}
+}
+
+// findPreviousVersion returns the version that was promoted to the stage before the given version, according to the
+// promotion history of the application versions. This is the version with the latest promotion to the stage before the
+// given version was promoted to it, whether or not it has since been promoted onward.
+func (rv *rollbackAppVersionCommand) findPreviousVersion(ctx service.Context, version string) (*model.AppVersion, error) {
+	appVersions, err := utils.ListAllAppVersions(ctx, rv.versionService, rv.applicationKey)
+	if err != nil {
+		return nil, err
+	}
+	promotedAt, err := rv.promotionToStage(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous *model.AppVersion
+	var previousPromotedAt string
+	for i, appVersion := range appVersions {
+		// A version created after the given version was promoted cannot have been promoted before it.
+		if appVersion.Version == version || (promotedAt != "" && utils.CompareCreated(appVersion.Created, promotedAt) > 0) {
+			continue
+		}
+		candidatePromotedAt, err := rv.promotionToStage(ctx, appVersion.Version)
+		if err != nil {
+			return nil, err
+		}
+		if candidatePromotedAt == "" || (promotedAt != "" && utils.CompareCreated(candidatePromotedAt, promotedAt) >= 0) {
+			continue
+		}
+		if previous == nil || utils.CompareCreated(candidatePromotedAt, previousPromotedAt) > 0 {
+			previous, previousPromotedAt = &appVersions[i], candidatePromotedAt
+		}
+	}
+	if previous == nil {
+		return nil, errorutils.CheckErrorf("no other version of application '%s' was promoted to stage %s before version %s, so there is no version to restore",
+			rv.applicationKey, rv.fromStage, version)
+	}
+	return previous, nil
+}
+
+// promotionToStage returns the time of the last successful promotion of the version to the stage being rolled back.
+// Returns an empty string if the version was never promoted to the stage, or was rolled back from it since.
+func (rv *rollbackAppVersionCommand) promotionToStage(ctx service.Context, version string) (string, error) {
+	history, err := rv.versionService.GetAppVersionHistory(ctx, rv.applicationKey, version)
+	if err != nil {
+		return "", err
+	}
+	var promotedAt, rolledBackAt string
+	for _, event := range history.Events {
+		if strings.EqualFold(event.Status, model.VersionStatusFailed) {
+			continue
+		}
+		switch {
+		case strings.EqualFold(event.EventType, model.VersionEventPromotion) && strings.EqualFold(event.ToStage, rv.fromStage):
+			if promotedAt == "" || utils.CompareCreated(event.Created, promotedAt) > 0 {
+				promotedAt = event.Created
+			}
+		case strings.EqualFold(event.EventType, model.VersionEventRollback) && strings.EqualFold(event.FromStage, rv.fromStage):
+			if rolledBackAt == "" || utils.CompareCreated(event.Created, rolledBackAt) > 0 {
+				rolledBackAt = event.Created
+			}
+		}
+	}
+	if rolledBackAt != "" && utils.CompareCreated(rolledBackAt, promotedAt) >= 0 {
+		return "", nil
+	}
+	return promotedAt, nil
+}
+
+// confirmRestoredVersion verifies that after the rollback the rolled back version has left the stage,
+// and the promotion of the restored version to the stage was not rolled back.
+func (rv *rollbackAppVersionCommand) confirmRestoredVersion(ctx service.Context, version, restoredVersion string) error {
+	rolledBack, err := rv.versionService.GetAppVersion(ctx, rv.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(rolledBack.CurrentStage, rv.fromStage) {
+		return errorutils.CheckErrorf("expected version %s to have left stage %s after the rollback, but it is still in it",
+			version, rv.fromStage)
+	}
+	restoredPromotedAt, err := rv.promotionToStage(ctx, restoredVersion)
+	if err != nil {
+		return err
+	}
+	if restoredPromotedAt == "" {
+		return errorutils.CheckErrorf("expected version %s to be the version promoted to stage %s after the rollback, but it was rolled back from the stage",
+			restoredVersion, rv.fromStage)
+	}
+	log.Output(fmt.Sprintf("Restored version %s as the version promoted to stage %s.", restoredVersion, rv.fromStage))
+	return nil
 }
 
 func (rv *rollbackAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -52,7 +199,18 @@ func (rv *rollbackAppVersionCommand) prepareAndRunCommand(ctx *components.Contex
 	rv.version = ctx.Arguments[1]
 	rv.fromStage = ctx.Arguments[2]
 
-	rv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag)
+	rv.wait = ctx.GetBoolFlagValue(commands.WaitFlag)
+	rv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag) && !rv.wait
+	rv.restorePrevious = ctx.GetBoolFlagValue(commands.RestorePreviousFlag)
+	// The restored version can only be confirmed once the rollback completes.
+	if rv.restorePrevious && !rv.sync {
+		rv.wait = true
+	}
+	timeout, err := parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+	rv.timeout = timeout
 
 	serverDetails, err := utils.ServerDetailsByFlags(ctx)
 	if err != nil {
@@ -70,7 +228,7 @@ func GetRollbackAppVersionCommand(appContext app.Context) components.Command {
 	}
 	return components.Command{
 		Name:        commands.VersionRollback,
-		Description: "Roll back application version promotion.",
+		Description: "Roll back application version promotion, and print a summary of the stages the version was rolled back from and to.",
 		Category:    common.CategoryVersion,
 		Aliases:     []string{"vrb"},
 		Arguments: []components.Argument{
@@ -81,7 +239,7 @@ func GetRollbackAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The version to roll back.",
+				Description: "The version to roll back. " + versionSelectorsDescription,
 				Optional:    false,
 			},
 		},
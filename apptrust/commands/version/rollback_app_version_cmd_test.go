@@ -1,15 +1,19 @@
 package version
 
 import (
+	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
 	"go.uber.org/mock/gomock"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRollbackAppVersionCommand_Run(t *testing.T) {
@@ -63,7 +67,7 @@ func TestRollbackAppVersionCommand_Run(t *testing.T) {
 
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			mockVersionService.EXPECT().RollbackAppVersion(gomock.Any(), tt.applicationKey, tt.version, requestPayload, tt.sync).
-				Return(tt.mockError).Times(1)
+				Return(nil, tt.mockError).Times(1)
 
 			cmd := &rollbackAppVersionCommand{
 				versionService: mockVersionService,
@@ -88,3 +92,193 @@ func TestRollbackAppVersionCommand_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestRollbackAppVersionCommand_Run_WaitReportsCompletionAfterWaiting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	previousLogger := log.GetLogger()
+	defer log.SetLogger(previousLogger)
+	var output bytes.Buffer
+	log.SetLogger(log.NewLogger(log.ERROR, &output))
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		// The response of a submitted rollback does not describe its outcome yet.
+		mockVersionService.EXPECT().RollbackAppVersion(gomock.Any(), "app-key", "1.0.0", gomock.Any(), false).
+			Return(&model.RollbackAppVersionResponse{}, nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: model.VersionStatusCompleted, CurrentStage: "QA"}, nil),
+	)
+
+	cmd := &rollbackAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		requestPayload: &model.RollbackAppVersionRequest{FromStage: "PROD"},
+		fromStage:      "PROD",
+		wait:           true,
+		timeout:        time.Minute,
+	}
+
+	err := cmd.Run()
+	require.NoError(t, err)
+	assert.Equal(t, "Submitted the rollback of version 1.0.0 of application app-key from stage PROD.\n"+
+		"Rolled back version 1.0.0 of application app-key from stage PROD.\n", output.String())
+}
+
+func TestRollbackSummary(t *testing.T) {
+	assert.Equal(t, "Submitted the rollback of version 1.5.0 of application app-key from stage PROD.",
+		rollbackSummary("app-key", "1.5.0", "PROD", false, &model.RollbackAppVersionResponse{}))
+	assert.Equal(t, "Rolled back version 1.5.0 of application app-key from stage PROD.",
+		rollbackSummary("app-key", "1.5.0", "PROD", true, nil))
+	assert.Equal(t, "Rolled back version 1.5.0 of application app-key from stage PROD to stage QA.",
+		rollbackSummary("app-key", "1.5.0", "PROD", true, &model.RollbackAppVersionResponse{
+			AppVersionReference: model.AppVersionReference{ApplicationKey: "app-key", Version: "1.5.0"}, RollbackFromStage: "PROD", RollbackToStage: "QA",
+		}))
+	assert.Equal(t, "Rolled back version 1.5.0 of application app-key from stage DEV. The version is no longer in any stage.",
+		rollbackSummary("app-key", "1.5.0", "DEV", true, &model.RollbackAppVersionResponse{
+			AppVersionReference: model.AppVersionReference{ApplicationKey: "app-key", Version: "1.5.0"}, RollbackFromStage: "DEV",
+		}))
+}
+
+// promotionHistory returns a history with a completed promotion to each stage at the given time.
+func promotionHistory(promotions map[string]string) *model.AppVersionHistory {
+	history := &model.AppVersionHistory{}
+	for stage, created := range promotions {
+		history.Events = append(history.Events, model.VersionHistoryEvent{
+			EventType: model.VersionEventPromotion, Status: model.VersionStatusCompleted, ToStage: stage, Created: created,
+		})
+	}
+	return history
+}
+
+func newTestRestorePreviousCommand(mockVersionService *mockversions.MockVersionService) *rollbackAppVersionCommand {
+	return &rollbackAppVersionCommand{
+		versionService:  mockVersionService,
+		serverDetails:   &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:  "app-key",
+		version:         "1.2.0",
+		requestPayload:  &model.RollbackAppVersionRequest{FromStage: "PROD"},
+		fromStage:       "PROD",
+		sync:            true,
+		restorePrevious: true,
+	}
+}
+
+func TestRollbackAppVersionCommand_FindPreviousVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 0.9.0 was promoted to PROD latest before 1.2.0, but was rolled back from it since. 1.0.0 was then promoted to PROD
+	// before 1.2.0, and onward to GA. 1.1.0 was created after 1.0.0 but promoted to PROD before it. 1.3.0 was created
+	// after 1.2.0 was promoted, so its history is not needed.
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{
+			{Version: "0.9.0", CurrentStage: "QA", Created: "2024-12-01T10:00:00Z"},
+			{Version: "1.0.0", CurrentStage: "GA", Created: "2025-01-01T10:00:00Z"},
+			{Version: "1.1.0", CurrentStage: "PROD", Created: "2025-02-01T10:00:00Z"},
+			{Version: "1.2.0", CurrentStage: "PROD", Created: "2025-03-01T10:00:00Z"},
+			{Version: "1.3.0", CurrentStage: "PROD", Created: "2025-04-03T10:00:00Z"},
+		}, Total: 5}, nil)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "0.9.0").
+		Return(&model.AppVersionHistory{Events: []model.VersionHistoryEvent{
+			{EventType: model.VersionEventPromotion, Status: model.VersionStatusCompleted, ToStage: "PROD", Created: "2025-04-02T09:59:59.800Z"},
+			{EventType: model.VersionEventRollback, Status: model.VersionStatusCompleted, FromStage: "PROD", ToStage: "QA", Created: "2025-04-02T09:59:59.900Z"},
+		}}, nil)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.0.0").
+		Return(promotionHistory(map[string]string{"PROD": "2025-04-02T09:59:59.500Z", "GA": "2025-04-05T10:00:00Z"}), nil)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.1.0").
+		Return(promotionHistory(map[string]string{"PROD": "2025-03-01T10:00:00Z"}), nil)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.2.0").
+		Return(&model.AppVersionHistory{Events: []model.VersionHistoryEvent{
+			{EventType: model.VersionEventPromotion, Status: model.VersionStatusCompleted, ToStage: "PROD", Created: "2025-04-02T12:00:00+02:00"},
+			{EventType: model.VersionEventPromotion, Status: model.VersionStatusFailed, ToStage: "PROD", Created: "2025-06-01T10:00:00Z"},
+		}}, nil)
+
+	previous, err := newTestRestorePreviousCommand(mockVersionService).findPreviousVersion(nil, "1.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", previous.Version)
+}
+
+func TestRollbackAppVersionCommand_Run_RestorePrevious(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+			Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{
+				{Version: "1.1.0", CurrentStage: "GA"},
+				{Version: "1.2.0", CurrentStage: "PROD"},
+			}, Total: 2}, nil),
+		mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.2.0").
+			Return(promotionHistory(map[string]string{"PROD": "2025-03-01T10:00:00Z"}), nil),
+		mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.1.0").
+			Return(promotionHistory(map[string]string{"PROD": "2025-02-01T10:00:00Z", "GA": "2025-02-02T10:00:00Z"}), nil),
+		mockVersionService.EXPECT().RollbackAppVersion(gomock.Any(), "app-key", "1.2.0", gomock.Any(), true).
+			Return(&model.RollbackAppVersionResponse{
+				AppVersionReference: model.AppVersionReference{ApplicationKey: "app-key", Version: "1.2.0"},
+				RollbackFromStage:   "PROD",
+				RollbackToStage:     "QA",
+			}, nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.2.0").
+			Return(&model.AppVersion{Version: "1.2.0", CurrentStage: "QA"}, nil),
+		mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.1.0").
+			Return(promotionHistory(map[string]string{"PROD": "2025-02-01T10:00:00Z", "GA": "2025-02-02T10:00:00Z"}), nil),
+	)
+
+	err := newTestRestorePreviousCommand(mockVersionService).Run()
+	assert.NoError(t, err)
+}
+
+func TestRollbackAppVersionCommand_Run_RestorePrevious_NoPreviousVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{
+			{Version: "1.2.0", CurrentStage: "PROD"},
+			{Version: "1.3.0", CurrentStage: "PROD"},
+		}, Total: 2}, nil).Times(1)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.2.0").
+		Return(promotionHistory(map[string]string{"PROD": "2025-03-01T10:00:00Z"}), nil).Times(1)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.3.0").
+		Return(promotionHistory(map[string]string{"PROD": "2025-04-01T10:00:00Z"}), nil).Times(1)
+
+	err := newTestRestorePreviousCommand(mockVersionService).Run()
+	assert.EqualError(t, err, "no other version of application 'app-key' was promoted to stage PROD before version 1.2.0, so there is no version to restore")
+}
+
+func TestRollbackAppVersionCommand_Run_RestorePrevious_NotRestored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(&model.ListAppVersionsResponse{Versions: []model.AppVersion{
+			{Version: "1.1.0", CurrentStage: "PROD"},
+			{Version: "1.2.0", CurrentStage: "PROD"},
+		}, Total: 2}, nil).Times(1)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.2.0").
+		Return(promotionHistory(map[string]string{"PROD": "2025-03-01T10:00:00Z"}), nil).Times(1)
+	gomock.InOrder(
+		mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.1.0").
+			Return(promotionHistory(map[string]string{"PROD": "2025-02-01T10:00:00Z"}), nil),
+		// The restored version was rolled back from the stage concurrently.
+		mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.1.0").
+			Return(&model.AppVersionHistory{Events: []model.VersionHistoryEvent{
+				{EventType: model.VersionEventPromotion, Status: model.VersionStatusCompleted, ToStage: "PROD", Created: "2025-02-01T10:00:00Z"},
+				{EventType: model.VersionEventRollback, Status: model.VersionStatusCompleted, FromStage: "PROD", Created: "2025-03-02T10:00:00Z"},
+			}}, nil),
+	)
+	mockVersionService.EXPECT().RollbackAppVersion(gomock.Any(), "app-key", "1.2.0", gomock.Any(), true).Return(nil, nil).Times(1)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.2.0").
+		Return(&model.AppVersion{Version: "1.2.0", CurrentStage: "QA"}, nil).Times(1)
+
+	err := newTestRestorePreviousCommand(mockVersionService).Run()
+	assert.EqualError(t, err, "expected version 1.1.0 to be the version promoted to stage PROD after the rollback, but it was rolled back from the stage")
+}
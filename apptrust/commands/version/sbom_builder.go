@@ -0,0 +1,250 @@
+package version
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+const sbomToolName = "jfrog-cli-apptrust"
+
+// purlTypes maps the package types whose purl type has a different name.
+var purlTypes = map[string]string{
+	"alpine": "apk",
+	"debian": "deb",
+	"gems":   "gem",
+	"go":     "golang",
+}
+
+// sbomComponent is a format-neutral node of the SBOM tree.
+// An application version contains its packages and its nested application versions, and a package contains its artifacts.
+type sbomComponent struct {
+	kind       string
+	name       string
+	version    string
+	purl       string
+	sha256     string
+	components []*sbomComponent
+}
+
+// buildPackageComponents returns a package component per releasable, holding a file component per artifact.
+// Releasables that are already part of a nested application version are skipped, so that each package appears once.
+func buildPackageComponents(releasables []model.Releasable, nestedPurls map[string]bool) []*sbomComponent {
+	var components []*sbomComponent
+	seen := make(map[string]bool)
+	for _, releasable := range releasables {
+		purl := buildPurl(releasable.PackageType, releasable.Name, releasable.Version)
+		if seen[purl] || nestedPurls[purl] {
+			continue
+		}
+		seen[purl] = true
+
+		component := &sbomComponent{
+			kind:    model.CycloneDxComponentLibrary,
+			name:    releasable.Name,
+			version: releasable.Version,
+			purl:    purl,
+			sha256:  releasable.Sha256,
+		}
+		seenPaths := make(map[string]bool)
+		for _, artifact := range releasable.Artifacts {
+			path := strings.TrimPrefix(artifact.Path, "/")
+			if seenPaths[path] {
+				continue
+			}
+			seenPaths[path] = true
+			component.components = append(component.components, &sbomComponent{
+				kind:   model.CycloneDxComponentFile,
+				name:   path,
+				sha256: artifact.Sha256,
+			})
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// collectPurls adds the purls of all the packages under the component to purls.
+func collectPurls(component *sbomComponent, purls map[string]bool) {
+	if component.purl != "" {
+		purls[component.purl] = true
+	}
+	for _, child := range component.components {
+		collectPurls(child, purls)
+	}
+}
+
+// buildPurl returns the package URL of a package, such as pkg:npm/%40scope/name@1.0.0.
+// The part of the name before the last slash, or before the colon for Maven, is used as the purl namespace.
+func buildPurl(packageType, name, version string) string {
+	purlType := strings.ToLower(packageType)
+	if mapped, ok := purlTypes[purlType]; ok {
+		purlType = mapped
+	}
+
+	var namespace string
+	switch purlType {
+	case "maven":
+		if group, artifact, found := strings.Cut(name, ":"); found {
+			namespace, name = group, artifact
+		}
+	case "pypi":
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	}
+	if namespace == "" {
+		if index := strings.LastIndex(name, "/"); index >= 0 {
+			namespace, name = name[:index], name[index+1:]
+		}
+	}
+
+	purl := "pkg:" + purlType + "/"
+	if namespace != "" {
+		segments := strings.Split(namespace, "/")
+		for i, segment := range segments {
+			segments[i] = escapePurlSegment(segment)
+		}
+		purl += strings.Join(segments, "/") + "/"
+	}
+	purl += escapePurlSegment(name)
+	if version != "" {
+		purl += "@" + escapePurlSegment(version)
+	}
+	return purl
+}
+
+func escapePurlSegment(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), "@", "%40")
+}
+
+// toCycloneDx returns the CycloneDX document of the application version component.
+// The application version is the metadata component, and its packages and nested versions are the top-level components.
+func toCycloneDx(root *sbomComponent, serialNumber, timestamp string) *model.CycloneDxBom {
+	rootComponent := toCycloneDxComponent(root, "")
+	components := rootComponent.Components
+	if components == nil {
+		components = []model.CycloneDxComponent{}
+	}
+	rootComponent.Components = nil
+	return &model.CycloneDxBom{
+		BomFormat:    model.CycloneDxBomFormat,
+		SpecVersion:  model.CycloneDxSpecVersion,
+		SerialNumber: "urn:uuid:" + serialNumber,
+		Version:      1,
+		Metadata: model.CycloneDxMetadata{
+			Timestamp: timestamp,
+			Tools: &model.CycloneDxTools{Components: []model.CycloneDxComponent{
+				{Type: model.CycloneDxComponentApplication, Name: sbomToolName},
+			}},
+			Component: &rootComponent,
+		},
+		Components: components,
+	}
+}
+
+// toCycloneDxComponent converts the component and its children. The bom-ref of a child is prefixed with the bom-ref
+// of its parent, so that a package that appears in several application versions still has a unique bom-ref.
+func toCycloneDxComponent(component *sbomComponent, parentRef string) model.CycloneDxComponent {
+	ref := component.purl
+	switch {
+	case component.kind == model.CycloneDxComponentApplication:
+		ref = component.name + "@" + component.version
+	case ref == "":
+		ref = component.name
+	}
+	if parentRef != "" {
+		ref = parentRef + "|" + ref
+	}
+
+	converted := model.CycloneDxComponent{
+		Type:    component.kind,
+		BomRef:  ref,
+		Name:    component.name,
+		Version: component.version,
+		Purl:    component.purl,
+	}
+	if component.sha256 != "" {
+		converted.Hashes = []model.CycloneDxHash{{Alg: model.CycloneDxHashSha256, Content: component.sha256}}
+	}
+	for _, child := range component.components {
+		converted.Components = append(converted.Components, toCycloneDxComponent(child, ref))
+	}
+	return converted
+}
+
+// toSpdx returns the SPDX document of the application version component.
+// Every component is an SPDX package, and each parent CONTAINS its children.
+func toSpdx(root *sbomComponent, namespace, created string) *model.SpdxDocument {
+	document := &model.SpdxDocument{
+		SpdxVersion:       model.SpdxVersion,
+		DataLicense:       model.SpdxDataLicense,
+		SpdxId:            model.SpdxDocumentId,
+		Name:              root.name + "-" + root.version,
+		DocumentNamespace: namespace,
+		CreationInfo: model.SpdxCreationInfo{
+			Created:  created,
+			Creators: []string{"Tool: " + sbomToolName},
+		},
+		Packages:      []model.SpdxPackage{},
+		Relationships: []model.SpdxRelationship{},
+	}
+	rootId := addSpdxPackage(document, root)
+	document.DocumentDescribes = []string{rootId}
+	document.Relationships = append([]model.SpdxRelationship{{
+		SpdxElementId:      model.SpdxDocumentId,
+		RelationshipType:   model.SpdxRelationshipDescribes,
+		RelatedSpdxElement: rootId,
+	}}, document.Relationships...)
+	return document
+}
+
+// addSpdxPackage adds the component and its children to the document, and returns the SPDX ID of the component.
+func addSpdxPackage(document *model.SpdxDocument, component *sbomComponent) string {
+	spdxPackage := model.SpdxPackage{
+		SpdxId:           fmt.Sprintf("SPDXRef-%s-%d", spdxIdPrefix(component.kind), len(document.Packages)+1),
+		Name:             component.name,
+		VersionInfo:      component.version,
+		DownloadLocation: model.SpdxNoAssertion,
+	}
+	switch component.kind {
+	case model.CycloneDxComponentApplication:
+		spdxPackage.PrimaryPackagePurpose = model.SpdxPurposeApplication
+	case model.CycloneDxComponentFile:
+		spdxPackage.PrimaryPackagePurpose = model.SpdxPurposeFile
+	default:
+		spdxPackage.PrimaryPackagePurpose = model.SpdxPurposeLibrary
+	}
+	if component.sha256 != "" {
+		spdxPackage.Checksums = []model.SpdxChecksum{{Algorithm: model.SpdxChecksumSha256, ChecksumValue: component.sha256}}
+	}
+	if component.purl != "" {
+		spdxPackage.ExternalRefs = []model.SpdxExternalRef{{
+			ReferenceCategory: model.SpdxReferenceCategoryPackageManager,
+			ReferenceType:     model.SpdxReferenceTypePurl,
+			ReferenceLocator:  component.purl,
+		}}
+	}
+	document.Packages = append(document.Packages, spdxPackage)
+
+	for _, child := range component.components {
+		childId := addSpdxPackage(document, child)
+		document.Relationships = append(document.Relationships, model.SpdxRelationship{
+			SpdxElementId:      spdxPackage.SpdxId,
+			RelationshipType:   model.SpdxRelationshipContains,
+			RelatedSpdxElement: childId,
+		})
+	}
+	return spdxPackage.SpdxId
+}
+
+func spdxIdPrefix(kind string) string {
+	switch kind {
+	case model.CycloneDxComponentApplication:
+		return "Application"
+	case model.CycloneDxComponentFile:
+		return "File"
+	default:
+		return "Package"
+	}
+}
@@ -0,0 +1,126 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPurl(t *testing.T) {
+	tests := []struct {
+		packageType string
+		name        string
+		version     string
+		expected    string
+	}{
+		{packageType: "npm", name: "lodash", version: "4.17.21", expected: "pkg:npm/lodash@4.17.21"},
+		{packageType: "npm", name: "@gpizza/pizza-frontend", version: "1.0.0", expected: "pkg:npm/%40gpizza/pizza-frontend@1.0.0"},
+		{packageType: "maven", name: "org.jfrog:app", version: "1.0", expected: "pkg:maven/org.jfrog/app@1.0"},
+		{packageType: "pypi", name: "Django_Rest", version: "3.0", expected: "pkg:pypi/django-rest@3.0"},
+		{packageType: "go", name: "github.com/jfrog/gofrog", version: "v1.7.6", expected: "pkg:golang/github.com/jfrog/gofrog@v1.7.6"},
+		{packageType: "Docker", name: "library/nginx", version: "1.25", expected: "pkg:docker/library/nginx@1.25"},
+		{packageType: "generic", name: "my file", version: "", expected: "pkg:generic/my%20file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildPurl(tt.packageType, tt.name, tt.version))
+		})
+	}
+}
+
+func TestBuildPackageComponents(t *testing.T) {
+	releasables := []model.Releasable{
+		{Name: "frontend", Version: "1.0.0", PackageType: "npm", Sha256: "aaa", Artifacts: []model.ReleasableArtifact{
+			{Path: "/npm-local/frontend/-/frontend-1.0.0.tgz", Sha256: "aaa"},
+			{Path: "npm-local/frontend/-/frontend-1.0.0.tgz", Sha256: "aaa"},
+		}},
+		{Name: "frontend", Version: "1.0.0", PackageType: "npm"},
+		{Name: "backend", Version: "2.0.0", PackageType: "npm"},
+	}
+
+	components := buildPackageComponents(releasables, map[string]bool{"pkg:npm/backend@2.0.0": true})
+	require.Len(t, components, 1)
+	assert.Equal(t, "pkg:npm/frontend@1.0.0", components[0].purl)
+	assert.Equal(t, "aaa", components[0].sha256)
+	require.Len(t, components[0].components, 1)
+	assert.Equal(t, &sbomComponent{kind: model.CycloneDxComponentFile, name: "npm-local/frontend/-/frontend-1.0.0.tgz", sha256: "aaa"},
+		components[0].components[0])
+}
+
+func newTestSbomTree() *sbomComponent {
+	return &sbomComponent{kind: model.CycloneDxComponentApplication, name: "app", version: "1.0.0", components: []*sbomComponent{
+		{kind: model.CycloneDxComponentLibrary, name: "frontend", version: "1.0.0", purl: "pkg:npm/frontend@1.0.0", components: []*sbomComponent{
+			{kind: model.CycloneDxComponentFile, name: "npm-local/frontend-1.0.0.tgz", sha256: "aaa"},
+		}},
+		{kind: model.CycloneDxComponentApplication, name: "lib-app", version: "2.0.0", components: []*sbomComponent{
+			{kind: model.CycloneDxComponentLibrary, name: "backend", version: "2.0.0", purl: "pkg:npm/backend@2.0.0", sha256: "bbb"},
+		}},
+	}}
+}
+
+func TestToCycloneDx(t *testing.T) {
+	bom := toCycloneDx(newTestSbomTree(), "serial", "2025-01-01T10:00:00Z")
+
+	assert.Equal(t, model.CycloneDxBomFormat, bom.BomFormat)
+	assert.Equal(t, model.CycloneDxSpecVersion, bom.SpecVersion)
+	assert.Equal(t, "urn:uuid:serial", bom.SerialNumber)
+	assert.Equal(t, "2025-01-01T10:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, &model.CycloneDxComponent{Type: model.CycloneDxComponentApplication, BomRef: "app@1.0.0", Name: "app", Version: "1.0.0"},
+		bom.Metadata.Component)
+
+	assert.Equal(t, []model.CycloneDxComponent{
+		{
+			Type: model.CycloneDxComponentLibrary, BomRef: "app@1.0.0|pkg:npm/frontend@1.0.0", Name: "frontend", Version: "1.0.0", Purl: "pkg:npm/frontend@1.0.0",
+			Components: []model.CycloneDxComponent{{
+				Type: model.CycloneDxComponentFile, BomRef: "app@1.0.0|pkg:npm/frontend@1.0.0|npm-local/frontend-1.0.0.tgz", Name: "npm-local/frontend-1.0.0.tgz",
+				Hashes: []model.CycloneDxHash{{Alg: model.CycloneDxHashSha256, Content: "aaa"}},
+			}},
+		},
+		{
+			Type: model.CycloneDxComponentApplication, BomRef: "app@1.0.0|lib-app@2.0.0", Name: "lib-app", Version: "2.0.0",
+			Components: []model.CycloneDxComponent{{
+				Type: model.CycloneDxComponentLibrary, BomRef: "app@1.0.0|lib-app@2.0.0|pkg:npm/backend@2.0.0", Name: "backend", Version: "2.0.0", Purl: "pkg:npm/backend@2.0.0",
+				Hashes: []model.CycloneDxHash{{Alg: model.CycloneDxHashSha256, Content: "bbb"}},
+			}},
+		},
+	}, bom.Components)
+}
+
+func TestToCycloneDx_NoComponents(t *testing.T) {
+	bom := toCycloneDx(&sbomComponent{kind: model.CycloneDxComponentApplication, name: "app", version: "1.0.0"}, "serial", "2025-01-01T10:00:00Z")
+	assert.Equal(t, []model.CycloneDxComponent{}, bom.Components)
+}
+
+func TestToSpdx(t *testing.T) {
+	document := toSpdx(newTestSbomTree(), "https://example.com/apptrust/spdx/app/1.0.0-serial", "2025-01-01T10:00:00Z")
+
+	assert.Equal(t, model.SpdxVersion, document.SpdxVersion)
+	assert.Equal(t, model.SpdxDocumentId, document.SpdxId)
+	assert.Equal(t, "app-1.0.0", document.Name)
+	assert.Equal(t, "https://example.com/apptrust/spdx/app/1.0.0-serial", document.DocumentNamespace)
+	assert.Equal(t, []string{"SPDXRef-Application-1"}, document.DocumentDescribes)
+
+	ids := []string{}
+	for _, spdxPackage := range document.Packages {
+		ids = append(ids, spdxPackage.SpdxId)
+	}
+	assert.Equal(t, []string{"SPDXRef-Application-1", "SPDXRef-Package-2", "SPDXRef-File-3", "SPDXRef-Application-4", "SPDXRef-Package-5"}, ids)
+	assert.Equal(t, []model.SpdxExternalRef{{
+		ReferenceCategory: model.SpdxReferenceCategoryPackageManager,
+		ReferenceType:     model.SpdxReferenceTypePurl,
+		ReferenceLocator:  "pkg:npm/frontend@1.0.0",
+	}}, document.Packages[1].ExternalRefs)
+	assert.Equal(t, model.SpdxPurposeFile, document.Packages[2].PrimaryPackagePurpose)
+	assert.Equal(t, []model.SpdxChecksum{{Algorithm: model.SpdxChecksumSha256, ChecksumValue: "aaa"}}, document.Packages[2].Checksums)
+
+	assert.Equal(t, []model.SpdxRelationship{
+		{SpdxElementId: model.SpdxDocumentId, RelationshipType: model.SpdxRelationshipDescribes, RelatedSpdxElement: "SPDXRef-Application-1"},
+		{SpdxElementId: "SPDXRef-Package-2", RelationshipType: model.SpdxRelationshipContains, RelatedSpdxElement: "SPDXRef-File-3"},
+		{SpdxElementId: "SPDXRef-Application-1", RelationshipType: model.SpdxRelationshipContains, RelatedSpdxElement: "SPDXRef-Package-2"},
+		{SpdxElementId: "SPDXRef-Application-4", RelationshipType: model.SpdxRelationshipContains, RelatedSpdxElement: "SPDXRef-Package-5"},
+		{SpdxElementId: "SPDXRef-Application-1", RelationshipType: model.SpdxRelationshipContains, RelatedSpdxElement: "SPDXRef-Application-4"},
+	}, document.Relationships)
+}
@@ -3,6 +3,11 @@ package version
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"strings"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
@@ -15,7 +20,9 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
 )
 
 type updateAppVersionCommand struct {
@@ -33,7 +40,13 @@ func (uv *updateAppVersionCommand) Run() error {
 		return err
 	}
 
-	err = uv.versionService.UpdateAppVersion(ctx, uv.applicationKey, uv.version, uv.requestPayload)
+	version, err := resolveVersion(ctx, uv.versionService, uv.applicationKey, uv.version)
+	if err != nil {
+		log.Error("Failed to resolve application version:", err)
+		return err
+	}
+
+	err = uv.versionService.UpdateAppVersion(ctx, uv.applicationKey, version, uv.requestPayload)
 	if err != nil {
 		log.Error("Failed to update application version:", err)
 		return err
@@ -90,22 +103,94 @@ func (uv *updateAppVersionCommand) buildRequestPayload(ctx *components.Context)
 
 	// Handle properties - use spec format: key=value1[,value2,...]
 	if ctx.IsFlagSet(commands.PropertiesFlag) {
+		if ctx.IsFlagSet(commands.PropertiesFileFlag) {
+			return nil, errorutils.CheckErrorf("the flags --%s and --%s cannot be used together", commands.PropertiesFlag, commands.PropertiesFileFlag)
+		}
 		properties, err := utils.ParseListPropertiesFlag(ctx.GetStringFlagValue(commands.PropertiesFlag))
 		if err != nil {
 			return nil, err
 		}
 		request.Properties = properties
 	}
+	if ctx.IsFlagSet(commands.PropertiesFileFlag) {
+		properties, err := loadPropertiesFile(ctx.GetStringFlagValue(commands.PropertiesFileFlag))
+		if err != nil {
+			return nil, err
+		}
+		request.Properties = properties
+	}
 
 	// Handle delete properties
 	if ctx.IsFlagSet(commands.DeletePropertiesFlag) {
+		if ctx.IsFlagSet(commands.DeletePropertiesFileFlag) {
+			return nil, errorutils.CheckErrorf("the flags --%s and --%s cannot be used together", commands.DeletePropertiesFlag, commands.DeletePropertiesFileFlag)
+		}
 		deleteProps := utils.ParseSliceFlag(ctx.GetStringFlagValue(commands.DeletePropertiesFlag))
 		request.DeleteProperties = deleteProps
 	}
+	if ctx.IsFlagSet(commands.DeletePropertiesFileFlag) {
+		deleteProps, err := loadDeletePropertiesFile(ctx.GetStringFlagValue(commands.DeletePropertiesFileFlag))
+		if err != nil {
+			return nil, err
+		}
+		request.DeleteProperties = deleteProps
+	}
 
 	return request, nil
 }
 
+// loadPropertiesFile loads properties from a JSON or YAML file mapping each key to a list of values.
+// Values are taken as is, so they may contain any character. A key with no values clears its values.
+func loadPropertiesFile(filePath string) (map[string][]string, error) {
+	var properties map[string][]string
+	if err := loadStructuredFile(filePath, &properties); err != nil {
+		return nil, err
+	}
+	for key, values := range properties {
+		if strings.TrimSpace(key) == "" {
+			return nil, errorutils.CheckErrorf("property key cannot be empty")
+		}
+		if values == nil {
+			properties[key] = []string{}
+		}
+	}
+	return properties, nil
+}
+
+// loadDeletePropertiesFile loads the keys of the properties to delete from a JSON or YAML file holding a list of keys.
+func loadDeletePropertiesFile(filePath string) ([]string, error) {
+	var keys []string
+	if err := loadStructuredFile(filePath, &keys); err != nil {
+		return nil, err
+	}
+	if slices.ContainsFunc(keys, func(key string) bool { return strings.TrimSpace(key) == "" }) {
+		return nil, errorutils.CheckErrorf("property key cannot be empty")
+	}
+	return keys, nil
+}
+
+// loadStructuredFile decodes a JSON or YAML file into target, according to the file extension.
+func loadStructuredFile(filePath string, target any) error {
+	var unmarshal func([]byte, any) error
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		unmarshal = json.Unmarshal
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	default:
+		return errorutils.CheckErrorf("unsupported file type '%s'. Supported file types: .json, .yaml, .yml", filepath.Ext(filePath))
+	}
+
+	content, err := fileutils.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	if err = unmarshal(content, target); err != nil {
+		return errorutils.CheckErrorf("failed to parse %s: %s", filePath, err.Error())
+	}
+	return nil
+}
+
 func GetUpdateAppVersionCommand(appContext app.Context) components.Command {
 	cmd := &updateAppVersionCommand{versionService: appContext.GetVersionService()}
 	return components.Command{
@@ -121,7 +206,7 @@ func GetUpdateAppVersionCommand(appContext app.Context) components.Command {
 			},
 			{
 				Name:        "version",
-				Description: "The version number (in SemVer format) for the application version to update.",
+				Description: "The version number (in SemVer format) for the application version to update. " + versionSelectorsDescription,
 				Optional:    false,
 			},
 		},
@@ -73,6 +73,13 @@ func TestUpdateAppVersionCommand_Run(t *testing.T) {
 	}
 }
 
+var testFileProperties = map[string][]string{
+	"commit_message":   {"Fix parsing of a, b; and c"},
+	"build_url":        {"https://ci.example.com/job/build?id=1,2;3"},
+	"deployed_to":      {"staging-A", "staging-B"},
+	"old_feature_flag": {},
+}
+
 func TestUpdateAppVersionCommand_FlagsSuite(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -176,6 +183,74 @@ func TestUpdateAppVersionCommand_FlagsSuite(t *testing.T) {
 				Tag: "",
 			},
 		},
+		{
+			name: "properties file - json",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.PropertiesFileFlag, "./testfiles/properties.json")
+			},
+			expectsPayload: &model.UpdateAppVersionRequest{
+				Properties: testFileProperties,
+			},
+		},
+		{
+			name: "properties file - yaml",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.PropertiesFileFlag, "./testfiles/properties.yaml")
+			},
+			expectsPayload: &model.UpdateAppVersionRequest{
+				Properties: testFileProperties,
+			},
+		},
+		{
+			name: "delete properties file",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.DeletePropertiesFileFlag, "./testfiles/delete-properties.yaml")
+			},
+			expectsPayload: &model.UpdateAppVersionRequest{
+				DeleteProperties: []string{"legacy_param", "key;with,separators"},
+			},
+		},
+		{
+			name: "properties and properties file",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.PropertiesFlag, "status=rc")
+				ctx.AddStringFlag(commands.PropertiesFileFlag, "./testfiles/properties.json")
+			},
+			expectsError:  true,
+			errorContains: "the flags --properties and --properties-file cannot be used together",
+		},
+		{
+			name: "delete properties and delete properties file",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.DeletePropertiesFlag, "old_param")
+				ctx.AddStringFlag(commands.DeletePropertiesFileFlag, "./testfiles/delete-properties.yaml")
+			},
+			expectsError:  true,
+			errorContains: "the flags --delete-properties and --delete-properties-file cannot be used together",
+		},
+		{
+			name: "invalid properties file",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.PropertiesFileFlag, "./testfiles/invalid-properties.json")
+			},
+			expectsError:  true,
+			errorContains: "failed to parse ./testfiles/invalid-properties.json",
+		},
+		{
+			name: "unsupported properties file type",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.Arguments = []string{"app-key", "1.0.0"}
+				ctx.AddStringFlag(commands.PropertiesFileFlag, "./testfiles/properties.txt")
+			},
+			expectsError:  true,
+			errorContains: "unsupported file type '.txt'",
+		},
 		{
 			name: "invalid property format",
 			ctxSetup: func(ctx *components.Context) {
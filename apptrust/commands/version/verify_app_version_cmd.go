@@ -0,0 +1,271 @@
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	verifyVerified   = "verified"
+	verifyMismatched = "mismatched"
+	verifyMissing    = "missing"
+	verifyExtra      = "extra"
+)
+
+type verifyAppVersionCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	path           string
+	format         string
+}
+
+type verifyResultRow struct {
+	Path      string `json:"path,omitempty" col-name:"Path"`
+	LocalPath string `json:"local_path,omitempty" col-name:"Local Path"`
+	Result    string `json:"result" col-name:"Result"`
+	Details   string `json:"details,omitempty" col-name:"Details"`
+}
+
+func (vv *verifyAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*vv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, vv.versionService, vv.applicationKey, vv.version)
+	if err != nil {
+		return err
+	}
+
+	content, err := vv.versionService.GetAppVersionContent(ctx, vv.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	versionArtifacts, err := collectVersionArtifacts(content)
+	if err != nil {
+		return err
+	}
+	localFiles, err := hashLocalFiles(vv.path)
+	if err != nil {
+		return err
+	}
+
+	results := verifyArtifacts(versionArtifacts, localFiles)
+	if err = printVerifyResults(results, vv.format); err != nil {
+		return err
+	}
+	return checkVerifyResults(results)
+}
+
+// hashLocalFiles returns the sha256 of every regular file under root, keyed by its slash-separated path relative to root.
+func hashLocalFiles(root string) (map[string]string, error) {
+	exists, err := fileutils.IsDirExists(root, false)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errorutils.CheckErrorf("the directory %s does not exist", root)
+	}
+	// WalkDir does not follow a symlinked root, so the directory it points to is walked instead.
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+
+	checksums := make(map[string]string)
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		details, err := fileutils.GetFileDetails(path, true)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		checksums[filepath.ToSlash(relativePath)] = strings.ToLower(details.Checksum.Sha256)
+		return nil
+	})
+	return checksums, errorutils.CheckError(err)
+}
+
+// verifyArtifacts matches the version artifacts to the local files.
+// An artifact is first matched by its path, with or without its repository key. An artifact with no file at its path
+// is then matched by its checksum, so that files deployed with a different layout are also verified.
+// Local files that match no artifact are reported as extra.
+func verifyArtifacts(versionArtifacts []model.ReleasableArtifact, localFiles map[string]string) []verifyResultRow {
+	results := make([]verifyResultRow, len(versionArtifacts))
+	matched := make(map[string]bool)
+	var unmatched []int
+	for i, artifact := range versionArtifacts {
+		results[i] = verifyResultRow{Path: artifact.Path, Result: verifyMissing}
+		localPath, found := findLocalFileByPath(artifact.Path, localFiles)
+		if !found {
+			unmatched = append(unmatched, i)
+			continue
+		}
+		matched[localPath] = true
+		results[i].LocalPath = localPath
+		switch actualSha256 := localFiles[localPath]; {
+		case artifact.Sha256 == "":
+			results[i].Result = verifyMismatched
+			results[i].Details = "the version has no sha256 checksum recorded for the artifact"
+		case strings.EqualFold(actualSha256, artifact.Sha256):
+			results[i].Result = verifyVerified
+		default:
+			results[i].Result = verifyMismatched
+			results[i].Details = fmt.Sprintf("expected sha256 %s, but got %s", artifact.Sha256, actualSha256)
+		}
+	}
+
+	remaining := make([]string, 0, len(localFiles))
+	for localPath := range localFiles {
+		if !matched[localPath] {
+			remaining = append(remaining, localPath)
+		}
+	}
+	sort.Strings(remaining)
+	for _, i := range unmatched {
+		expectedSha256 := strings.ToLower(versionArtifacts[i].Sha256)
+		for _, localPath := range remaining {
+			if expectedSha256 != "" && !matched[localPath] && localFiles[localPath] == expectedSha256 {
+				matched[localPath] = true
+				results[i].LocalPath = localPath
+				results[i].Result = verifyVerified
+				results[i].Details = "matched by checksum"
+				break
+			}
+		}
+	}
+
+	for _, localPath := range remaining {
+		if !matched[localPath] {
+			results = append(results, verifyResultRow{LocalPath: localPath, Result: verifyExtra})
+		}
+	}
+	return results
+}
+
+// findLocalFileByPath looks for the artifact at its full path, and then at its path without the repository key.
+func findLocalFileByPath(artifactPath string, localFiles map[string]string) (string, bool) {
+	if _, found := localFiles[artifactPath]; found {
+		return artifactPath, true
+	}
+	if _, pathInRepository, cut := strings.Cut(artifactPath, "/"); cut {
+		if _, found := localFiles[pathInRepository]; found {
+			return pathInRepository, true
+		}
+	}
+	return "", false
+}
+
+func printVerifyResults(results []verifyResultRow, format string) error {
+	if format == model.OutputFormatJson {
+		content, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	return coreutils.PrintTable(results, "Verification Summary", "The version has no artifacts and no local files were found.", false)
+}
+
+// checkVerifyResults fails if an artifact is mismatched or missing. Extra local files are reported, but do not fail the verification.
+func checkVerifyResults(results []verifyResultRow) error {
+	counts := make(map[string]int)
+	for _, result := range results {
+		counts[result.Result]++
+	}
+	log.Info(fmt.Sprintf("%d verified, %d mismatched, %d missing and %d extra file(s).",
+		counts[verifyVerified], counts[verifyMismatched], counts[verifyMissing], counts[verifyExtra]))
+	if counts[verifyMismatched] > 0 || counts[verifyMissing] > 0 {
+		return errorutils.CheckErrorf("verification failed: %d mismatched and %d missing file(s)",
+			counts[verifyMismatched], counts[verifyMissing])
+	}
+	return nil
+}
+
+func (vv *verifyAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return vv.serverDetails, nil
+}
+
+func (vv *verifyAppVersionCommand) CommandName() string {
+	return commands.VersionVerify
+}
+
+func (vv *verifyAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	vv.applicationKey = ctx.Arguments[0]
+	vv.version = ctx.Arguments[1]
+
+	if err := utils.AssertValueProvided(ctx, commands.PathFlag); err != nil {
+		return err
+	}
+	vv.path = ctx.GetStringFlagValue(commands.PathFlag)
+
+	var err error
+	vv.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.OutputFormatValues)
+	if err != nil {
+		return err
+	}
+
+	vv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(vv)
+}
+
+func GetVerifyAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &verifyAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionVerify,
+		Description: "Verify that local files match the sha256 checksums of the artifacts of an application version. Fails if a file is mismatched or missing.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vvf"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to verify against. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionVerify),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,231 @@
+package version
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func writeTestFile(t *testing.T, root, relativePath, content string) {
+	path := filepath.Join(root, filepath.FromSlash(relativePath))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestHashLocalFiles(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, root, "repo/a/file.txt", "a")
+	writeTestFile(t, root, "b.txt", "b")
+
+	checksums, err := hashLocalFiles(root)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"repo/a/file.txt": testSha256("a"),
+		"b.txt":           testSha256("b"),
+	}, checksums)
+}
+
+func TestHashLocalFiles_SymlinkedRoot(t *testing.T) {
+	target := t.TempDir()
+	writeTestFile(t, target, "repo/a/file.txt", "a")
+	root := filepath.Join(t.TempDir(), "link")
+	require.NoError(t, os.Symlink(target, root))
+
+	checksums, err := hashLocalFiles(root)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"repo/a/file.txt": testSha256("a")}, checksums)
+}
+
+func TestHashLocalFiles_MissingDirectory(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "missing")
+
+	_, err := hashLocalFiles(root)
+	assert.EqualError(t, err, "the directory "+root+" does not exist")
+}
+
+func TestVerifyArtifacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		artifacts  []model.ReleasableArtifact
+		localFiles map[string]string
+		expected   []verifyResultRow
+	}{
+		{
+			name:       "matched by full path",
+			artifacts:  []model.ReleasableArtifact{{Path: "repo/a/file.txt", Sha256: testSha256("a")}},
+			localFiles: map[string]string{"repo/a/file.txt": testSha256("a")},
+			expected:   []verifyResultRow{{Path: "repo/a/file.txt", LocalPath: "repo/a/file.txt", Result: verifyVerified}},
+		},
+		{
+			name:       "matched by path without repository",
+			artifacts:  []model.ReleasableArtifact{{Path: "repo/a/file.txt", Sha256: testSha256("a")}},
+			localFiles: map[string]string{"a/file.txt": testSha256("a")},
+			expected:   []verifyResultRow{{Path: "repo/a/file.txt", LocalPath: "a/file.txt", Result: verifyVerified}},
+		},
+		{
+			name:       "matched by checksum",
+			artifacts:  []model.ReleasableArtifact{{Path: "repo/a/file.txt", Sha256: testSha256("a")}},
+			localFiles: map[string]string{"bin/renamed": testSha256("a")},
+			expected:   []verifyResultRow{{Path: "repo/a/file.txt", LocalPath: "bin/renamed", Result: verifyVerified, Details: "matched by checksum"}},
+		},
+		{
+			name:       "mismatched",
+			artifacts:  []model.ReleasableArtifact{{Path: "repo/a/file.txt", Sha256: testSha256("a")}},
+			localFiles: map[string]string{"repo/a/file.txt": testSha256("tampered")},
+			expected: []verifyResultRow{{
+				Path: "repo/a/file.txt", LocalPath: "repo/a/file.txt", Result: verifyMismatched,
+				Details: "expected sha256 " + testSha256("a") + ", but got " + testSha256("tampered"),
+			}},
+		},
+		{
+			name:       "no recorded checksum",
+			artifacts:  []model.ReleasableArtifact{{Path: "repo/a/file.txt"}},
+			localFiles: map[string]string{"repo/a/file.txt": testSha256("a")},
+			expected: []verifyResultRow{{
+				Path: "repo/a/file.txt", LocalPath: "repo/a/file.txt", Result: verifyMismatched,
+				Details: "the version has no sha256 checksum recorded for the artifact",
+			}},
+		},
+		{
+			name: "missing and extra",
+			artifacts: []model.ReleasableArtifact{
+				{Path: "repo/a/file.txt", Sha256: testSha256("a")},
+				{Path: "repo/b/file.txt", Sha256: testSha256("b")},
+			},
+			localFiles: map[string]string{"repo/a/file.txt": testSha256("a"), "z.txt": testSha256("z"), "c.txt": testSha256("c")},
+			expected: []verifyResultRow{
+				{Path: "repo/a/file.txt", LocalPath: "repo/a/file.txt", Result: verifyVerified},
+				{Path: "repo/b/file.txt", Result: verifyMissing},
+				{LocalPath: "c.txt", Result: verifyExtra},
+				{LocalPath: "z.txt", Result: verifyExtra},
+			},
+		},
+		{
+			name: "identical artifacts match different files",
+			artifacts: []model.ReleasableArtifact{
+				{Path: "repo/a/file.txt", Sha256: testSha256("same")},
+				{Path: "repo/b/file.txt", Sha256: testSha256("same")},
+			},
+			localFiles: map[string]string{"x.txt": testSha256("same")},
+			expected: []verifyResultRow{
+				{Path: "repo/a/file.txt", LocalPath: "x.txt", Result: verifyVerified, Details: "matched by checksum"},
+				{Path: "repo/b/file.txt", Result: verifyMissing},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, verifyArtifacts(tt.artifacts, tt.localFiles))
+		})
+	}
+}
+
+func TestVerifyAppVersionCommand_Run(t *testing.T) {
+	tests := []struct {
+		name          string
+		localContent  string
+		expectedError string
+	}{
+		{name: "verified", localContent: testArtifactContent},
+		{name: "mismatched", localContent: "tampered content", expectedError: "verification failed: 1 mismatched and 0 missing file(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			root := t.TempDir()
+			writeTestFile(t, root, "repo/file.txt", tt.localContent)
+			writeTestFile(t, root, "config.yaml", "extra")
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+				Return(&model.VersionContent{Releasables: []model.Releasable{{
+					Name:      "pkg",
+					Artifacts: []model.ReleasableArtifact{{Path: "repo/file.txt", Sha256: testSha256(testArtifactContent)}},
+				}}}, nil).Times(1)
+
+			cmd := &verifyAppVersionCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				path:           root,
+				format:         model.OutputFormatJson,
+			}
+
+			err := cmd.Run()
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAppVersionCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("content error")).Times(1)
+
+	cmd := &verifyAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		path:           t.TempDir(),
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "content error")
+}
+
+func TestVerifyAppVersionCommand_PrepareValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctxSetup      func(*components.Context)
+		expectedError string
+	}{
+		{
+			name:          "missing path",
+			ctxSetup:      func(ctx *components.Context) {},
+			expectedError: "the --path option is mandatory",
+		},
+		{
+			name: "invalid format",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.PathFlag, "./deployed")
+				ctx.AddStringFlag(commands.FormatFlag, "xml")
+			},
+			expectedError: "invalid value for --format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+			tt.ctxSetup(ctx)
+
+			cmd := &verifyAppVersionCommand{}
+			err := cmd.prepareAndRunCommand(ctx)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package version
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type versionContentCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	filter         string
+	format         string
+}
+
+// resolvedContent lists the packages and artifacts that ended up in an application version.
+type resolvedContent struct {
+	Packages  []resolvedPackage  `json:"packages"`
+	Artifacts []resolvedArtifact `json:"artifacts"`
+}
+
+type resolvedPackage struct {
+	Type    string `json:"type" col-name:"Type"`
+	Name    string `json:"name" col-name:"Name"`
+	Version string `json:"version" col-name:"Version"`
+}
+
+type resolvedArtifact struct {
+	Path       string `json:"path" col-name:"Path"`
+	Sha256     string `json:"sha256" col-name:"SHA256"`
+	Repository string `json:"repository" col-name:"Repository"`
+}
+
+func (vc *versionContentCommand) Run() error {
+	ctx, err := service.NewContext(*vc.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	versionContent, err := vc.versionService.GetAppVersionContent(ctx, vc.applicationKey, vc.version)
+	if err != nil {
+		return err
+	}
+
+	content, err := resolveVersionContent(versionContent, vc.filter)
+	if err != nil {
+		return err
+	}
+
+	switch vc.format {
+	case model.OutputFormatJson:
+		return printContentJson(content)
+	case model.OutputFormatCsv:
+		return printContentCsv(content)
+	default:
+		return printContentTable(content)
+	}
+}
+
+// resolveVersionContent flattens the releasables of a version into packages and artifacts.
+// If a filter is provided, only packages whose name matches it and artifacts whose path matches it are returned.
+func resolveVersionContent(versionContent *model.VersionContent, filter string) (*resolvedContent, error) {
+	content := &resolvedContent{Packages: []resolvedPackage{}, Artifacts: []resolvedArtifact{}}
+	for _, releasable := range versionContent.Releasables {
+		matched, err := matchesFilter(filter, releasable.Name)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			content.Packages = append(content.Packages, resolvedPackage{
+				Type:    releasable.PackageType,
+				Name:    releasable.Name,
+				Version: releasable.Version,
+			})
+		}
+
+		for _, artifact := range releasable.Artifacts {
+			matched, err = matchesFilter(filter, artifact.Path)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				content.Artifacts = append(content.Artifacts, resolvedArtifact{
+					Path:       artifact.Path,
+					Sha256:     artifact.Sha256,
+					Repository: artifactRepository(releasable, artifact),
+				})
+			}
+		}
+	}
+	return content, nil
+}
+
+func matchesFilter(filter, value string) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	matched, err := stringutils.MatchWildcardPattern(filter, value)
+	return matched, errorutils.CheckError(err)
+}
+
+// artifactRepository returns the repository of the releasable, or the first segment of the artifact path if it is not set.
+func artifactRepository(releasable model.Releasable, artifact model.ReleasableArtifact) string {
+	if releasable.RepositoryKey != "" {
+		return releasable.RepositoryKey
+	}
+	repository, _, _ := strings.Cut(strings.TrimPrefix(artifact.Path, "/"), "/")
+	return repository
+}
+
+func printContentJson(content *resolvedContent) error {
+	output, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(output))
+	return nil
+}
+
+// printContentCsv prints a single CSV table, where the kind column tells packages and artifacts apart.
+func printContentCsv(content *resolvedContent) error {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	records := [][]string{{"kind", "type", "name", "version", "path", "sha256", "repository"}}
+	for _, pkg := range content.Packages {
+		records = append(records, []string{"package", pkg.Type, pkg.Name, pkg.Version, "", "", ""})
+	}
+	for _, artifact := range content.Artifacts {
+		records = append(records, []string{"artifact", "", "", "", artifact.Path, artifact.Sha256, artifact.Repository})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(strings.TrimSuffix(buffer.String(), "\n"))
+	return nil
+}
+
+func printContentTable(content *resolvedContent) error {
+	if err := coreutils.PrintTable(content.Packages, "Packages", "No packages found.", false); err != nil {
+		return err
+	}
+	return coreutils.PrintTable(content.Artifacts, "Artifacts", "No artifacts found.", false)
+}
+
+func (vc *versionContentCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return vc.serverDetails, nil
+}
+
+func (vc *versionContentCommand) CommandName() string {
+	return commands.VersionContent
+}
+
+func (vc *versionContentCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	vc.applicationKey = ctx.Arguments[0]
+	vc.version = ctx.Arguments[1]
+	vc.filter = ctx.GetStringFlagValue(commands.FilterFlag)
+
+	var err error
+	vc.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.VersionContentFormatValues)
+	if err != nil {
+		return err
+	}
+
+	vc.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(vc)
+}
+
+func GetVersionContentCommand(appContext app.Context) components.Command {
+	cmd := &versionContentCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionContent,
+		Description: "List the packages and artifacts resolved into an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vcn"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to list the content of.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionContent),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
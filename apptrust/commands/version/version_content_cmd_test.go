@@ -0,0 +1,139 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testVersionContent = &model.VersionContent{
+	ApplicationKey: "app-key",
+	Version:        "1.0.0",
+	Releasables: []model.Releasable{
+		{
+			Name:          "frontend",
+			Version:       "2.0.0",
+			PackageType:   "npm",
+			RepositoryKey: "npm-local",
+			Artifacts:     []model.ReleasableArtifact{{Path: "npm-local/frontend/-/frontend-2.0.0.tgz", Sha256: "aaa"}},
+		},
+		{
+			Name:        "backend",
+			Version:     "3.1.0",
+			PackageType: "generic",
+			Artifacts: []model.ReleasableArtifact{
+				{Path: "generic-local/backend/backend-3.1.0.jar", Sha256: "bbb"},
+				{Path: "generic-local/backend/backend-3.1.0.pom", Sha256: "ccc"},
+			},
+		},
+	},
+}
+
+func TestResolveVersionContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected *resolvedContent
+	}{
+		{
+			name: "no filter",
+			expected: &resolvedContent{
+				Packages: []resolvedPackage{
+					{Type: "npm", Name: "frontend", Version: "2.0.0"},
+					{Type: "generic", Name: "backend", Version: "3.1.0"},
+				},
+				Artifacts: []resolvedArtifact{
+					{Path: "npm-local/frontend/-/frontend-2.0.0.tgz", Sha256: "aaa", Repository: "npm-local"},
+					{Path: "generic-local/backend/backend-3.1.0.jar", Sha256: "bbb", Repository: "generic-local"},
+					{Path: "generic-local/backend/backend-3.1.0.pom", Sha256: "ccc", Repository: "generic-local"},
+				},
+			},
+		},
+		{
+			name:   "artifact path filter",
+			filter: "*.jar",
+			expected: &resolvedContent{
+				Packages: []resolvedPackage{},
+				Artifacts: []resolvedArtifact{
+					{Path: "generic-local/backend/backend-3.1.0.jar", Sha256: "bbb", Repository: "generic-local"},
+				},
+			},
+		},
+		{
+			name:   "package name filter",
+			filter: "front*",
+			expected: &resolvedContent{
+				Packages:  []resolvedPackage{{Type: "npm", Name: "frontend", Version: "2.0.0"}},
+				Artifacts: []resolvedArtifact{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := resolveVersionContent(testVersionContent, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func TestVersionContentCommand_Run(t *testing.T) {
+	for _, format := range model.VersionContentFormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+				Return(testVersionContent, nil).Times(1)
+
+			cmd := &versionContentCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionContentCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("content error")).Times(1)
+
+	cmd := &versionContentCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "content error")
+}
+
+func TestVersionContentCommand_InvalidFormat(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+	ctx.AddStringFlag(commands.FormatFlag, "xml")
+
+	cmd := &versionContentCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --format: 'xml'. Allowed values: table, json and csv")
+}
@@ -0,0 +1,151 @@
+package version
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type versionHistoryCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	stage          string
+	format         string
+}
+
+type historyEventRow struct {
+	Created   string `col-name:"Created"`
+	EventType string `col-name:"Event"`
+	FromStage string `col-name:"From Stage"`
+	ToStage   string `col-name:"To Stage"`
+	Status    string `col-name:"Status"`
+	CreatedBy string `col-name:"Created By"`
+}
+
+func (vh *versionHistoryCommand) Run() error {
+	ctx, err := service.NewContext(*vh.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, vh.versionService, vh.applicationKey, vh.version)
+	if err != nil {
+		return err
+	}
+
+	history, err := vh.versionService.GetAppVersionHistory(ctx, vh.applicationKey, version)
+	if err != nil {
+		return err
+	}
+	history.Events = buildTimeline(history.Events, vh.stage)
+
+	if vh.format == model.OutputFormatJson {
+		content, err := json.MarshalIndent(history, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+
+	rows := make([]historyEventRow, len(history.Events))
+	for i, event := range history.Events {
+		rows[i] = historyEventRow{
+			Created:   event.Created,
+			EventType: event.EventType,
+			FromStage: event.FromStage,
+			ToStage:   event.ToStage,
+			Status:    event.Status,
+			CreatedBy: event.CreatedBy,
+		}
+	}
+	return coreutils.PrintTable(rows, "Version History", "No promotions, releases or rollbacks were found.", false)
+}
+
+// buildTimeline returns the events in chronological order.
+// If a stage is provided, only the events that moved the version into or out of the stage are kept.
+func buildTimeline(events []model.VersionHistoryEvent, stage string) []model.VersionHistoryEvent {
+	timeline := []model.VersionHistoryEvent{}
+	for _, event := range events {
+		if stage == "" || strings.EqualFold(event.FromStage, stage) || strings.EqualFold(event.ToStage, stage) {
+			timeline = append(timeline, event)
+		}
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return utils.CompareCreated(timeline[i].Created, timeline[j].Created) < 0
+	})
+	return timeline
+}
+
+func (vh *versionHistoryCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return vh.serverDetails, nil
+}
+
+func (vh *versionHistoryCommand) CommandName() string {
+	return commands.VersionHistory
+}
+
+func (vh *versionHistoryCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	vh.applicationKey = ctx.Arguments[0]
+	vh.version = ctx.Arguments[1]
+	vh.stage = ctx.GetStringFlagValue(commands.StageVarsFlag)
+
+	var err error
+	vh.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.OutputFormatTable, model.OutputFormatValues)
+	if err != nil {
+		return err
+	}
+
+	vh.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(vh)
+}
+
+func GetVersionHistoryCommand(appContext app.Context) components.Command {
+	cmd := &versionHistoryCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionHistory,
+		Description: "Show the promotions, releases and rollbacks of an application version in chronological order.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vh"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version whose history to show. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionHistory),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
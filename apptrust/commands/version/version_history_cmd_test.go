@@ -0,0 +1,103 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var testHistoryEvents = []model.VersionHistoryEvent{
+	{EventType: model.VersionEventRelease, Status: "COMPLETED", FromStage: "QA", ToStage: "PROD", Created: "2025-01-03T10:00:00Z"},
+	{EventType: model.VersionEventPromotion, Status: "COMPLETED", ToStage: "DEV", Created: "2025-01-01T10:00:00Z"},
+	{EventType: model.VersionEventPromotion, Status: "COMPLETED", FromStage: "DEV", ToStage: "QA", Created: "2025-01-02T10:00:00Z"},
+	{EventType: model.VersionEventRollback, Status: "COMPLETED", FromStage: "PROD", ToStage: "QA", Created: "2025-01-04T10:00:00Z"},
+}
+
+func TestBuildTimeline(t *testing.T) {
+	tests := []struct {
+		name     string
+		stage    string
+		expected []string
+	}{
+		{name: "all stages", expected: []string{"2025-01-01T10:00:00Z", "2025-01-02T10:00:00Z", "2025-01-03T10:00:00Z", "2025-01-04T10:00:00Z"}},
+		{name: "single stage", stage: "prod", expected: []string{"2025-01-03T10:00:00Z", "2025-01-04T10:00:00Z"}},
+		{name: "unknown stage", stage: "STAGING", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := buildTimeline(testHistoryEvents, tt.stage)
+			created := []string{}
+			for _, event := range timeline {
+				created = append(created, event.Created)
+			}
+			assert.Equal(t, tt.expected, created)
+		})
+	}
+}
+
+func TestBuildTimeline_OrderedByInstant(t *testing.T) {
+	events := []model.VersionHistoryEvent{
+		{EventType: model.VersionEventPromotion, ToStage: "QA", Created: "2025-01-01T11:00:00+02:00"},
+		{EventType: model.VersionEventPromotion, ToStage: "PROD", Created: "2025-01-01T10:00:00.500Z"},
+		{EventType: model.VersionEventPromotion, ToStage: "DEV", Created: "2025-01-01T10:00:00Z"},
+	}
+
+	timeline := buildTimeline(events, "")
+	stages := []string{}
+	for _, event := range timeline {
+		stages = append(stages, event.ToStage)
+	}
+	assert.Equal(t, []string{"QA", "DEV", "PROD"}, stages)
+}
+
+func TestVersionHistoryCommand_Run(t *testing.T) {
+	for _, format := range model.OutputFormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.0.0").
+				Return(&model.AppVersionHistory{
+					AppVersionReference: model.AppVersionReference{ApplicationKey: "app-key", Version: "1.0.0"},
+					Events:              testHistoryEvents,
+				}, nil).Times(1)
+
+			cmd := &versionHistoryCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionHistoryCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("history error")).Times(1)
+
+	cmd := &versionHistoryCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "history error")
+}
@@ -0,0 +1,89 @@
+package version
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	latestVersionRef    = "latest"
+	releasedVersionRef  = "released"
+	tagVersionRefPrefix = "tag:"
+	stageRefSeparator   = "@"
+)
+
+// versionSelectorsDescription documents the supported selectors in the help of the version arguments.
+const versionSelectorsDescription = "Instead of a version, one of the following selectors can be provided: " +
+	"latest, latest@<stage>, released or tag:<tag>."
+
+// versionSelector describes the versions a symbolic reference may resolve to.
+// The most recently created version matching all the criteria is selected.
+type versionSelector struct {
+	stage    string
+	tag      string
+	released bool
+}
+
+// parseVersionSelector parses a symbolic version reference.
+// Returns nil if the reference is a literal version.
+func parseVersionSelector(reference string) (*versionSelector, error) {
+	switch {
+	case reference == latestVersionRef:
+		return &versionSelector{}, nil
+	case strings.HasPrefix(reference, latestVersionRef+stageRefSeparator):
+		stage := strings.TrimPrefix(reference, latestVersionRef+stageRefSeparator)
+		if stage == "" {
+			return nil, errorutils.CheckErrorf("invalid version selector '%s'. Expected %s%s<stage>", reference, latestVersionRef, stageRefSeparator)
+		}
+		return &versionSelector{stage: stage}, nil
+	case reference == releasedVersionRef:
+		return &versionSelector{released: true}, nil
+	case strings.HasPrefix(reference, tagVersionRefPrefix):
+		tag := strings.TrimPrefix(reference, tagVersionRefPrefix)
+		if tag == "" {
+			return nil, errorutils.CheckErrorf("invalid version selector '%s'. Expected %s<tag>", reference, tagVersionRefPrefix)
+		}
+		return &versionSelector{tag: tag}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (vs *versionSelector) matches(appVersion model.AppVersion) bool {
+	if vs.stage != "" && !strings.EqualFold(appVersion.CurrentStage, vs.stage) {
+		return false
+	}
+	if vs.tag != "" && appVersion.Tag != vs.tag {
+		return false
+	}
+	return !vs.released || strings.EqualFold(appVersion.ReleaseStatus, model.ReleaseStatusReleased) ||
+		strings.EqualFold(appVersion.ReleaseStatus, model.ReleaseStatusTrustedRelease)
+}
+
+// resolveVersion resolves a symbolic version reference, such as latest@QA, to a concrete version.
+// Literal versions are returned as is, without querying the server.
+func resolveVersion(ctx service.Context, versionService versions.VersionService, applicationKey, reference string) (string, error) {
+	selector, err := parseVersionSelector(reference)
+	if err != nil || selector == nil {
+		return reference, err
+	}
+
+	appVersions, err := utils.ListAllAppVersions(ctx, versionService, applicationKey)
+	if err != nil {
+		return "", err
+	}
+	sortAppVersions(appVersions, model.VersionSortByCreated, model.SortOrderDesc)
+	for _, appVersion := range appVersions {
+		if selector.matches(appVersion) {
+			log.Info("Resolved", reference, "to version", appVersion.Version+".")
+			return appVersion.Version, nil
+		}
+	}
+	return "", errorutils.CheckErrorf("no version of application '%s' matches '%s'", applicationKey, reference)
+}
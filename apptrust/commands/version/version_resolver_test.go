@@ -0,0 +1,104 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseVersionSelector(t *testing.T) {
+	tests := []struct {
+		reference   string
+		expected    *versionSelector
+		expectedErr string
+	}{
+		{reference: "1.0.0"},
+		{reference: "latest-build"},
+		{reference: "latest", expected: &versionSelector{}},
+		{reference: "latest@QA", expected: &versionSelector{stage: "QA"}},
+		{reference: "released", expected: &versionSelector{released: true}},
+		{reference: "tag:rc", expected: &versionSelector{tag: "rc"}},
+		{reference: "latest@", expectedErr: "invalid version selector 'latest@'. Expected latest@<stage>"},
+		{reference: "tag:", expectedErr: "invalid version selector 'tag:'. Expected tag:<tag>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			selector, err := parseVersionSelector(tt.reference)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, selector)
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	appVersions := []model.AppVersion{
+		{Version: "1.0.0", CurrentStage: "PROD", ReleaseStatus: "RELEASED", Tag: "rc", Created: "2025-01-01T10:00:00Z"},
+		{Version: "1.1.0", CurrentStage: "QA", Tag: "rc", Created: "2025-02-01T10:00:00Z"},
+		{Version: "1.2.0", CurrentStage: "QA", Created: "2025-03-01T10:00:00Z"},
+		{Version: "1.3.0", Created: "2025-04-01T10:00:00Z"},
+	}
+
+	tests := []struct {
+		reference   string
+		expected    string
+		expectedErr string
+	}{
+		{reference: "latest", expected: "1.3.0"},
+		{reference: "latest@qa", expected: "1.2.0"},
+		{reference: "latest@PROD", expected: "1.0.0"},
+		{reference: "released", expected: "1.0.0"},
+		{reference: "tag:rc", expected: "1.1.0"},
+		{reference: "latest@DEV", expectedErr: "no version of application 'app-key' matches 'latest@DEV'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+				Return(&model.ListAppVersionsResponse{Versions: appVersions, Total: len(appVersions)}, nil).Times(1)
+
+			version, err := resolveVersion(nil, mockVersionService, "app-key", tt.reference)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
+
+func TestResolveVersion_Literal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// A literal version is returned without listing the versions of the application.
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	version, err := resolveVersion(nil, mockVersionService, "app-key", "2.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.0", version)
+}
+
+func TestResolveVersion_ListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(nil, errors.New("list error")).Times(1)
+
+	_, err := resolveVersion(nil, mockVersionService, "app-key", "latest")
+	assert.EqualError(t, err, "list error")
+}
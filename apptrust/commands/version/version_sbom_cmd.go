@@ -0,0 +1,157 @@
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type versionSbomCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	format         string
+}
+
+func (vs *versionSbomCommand) Run() error {
+	ctx, err := service.NewContext(*vs.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	version, err := resolveVersion(ctx, vs.versionService, vs.applicationKey, vs.version)
+	if err != nil {
+		return err
+	}
+
+	root, err := vs.buildApplicationComponent(ctx, vs.applicationKey, version, make(map[string]bool))
+	if err != nil {
+		return err
+	}
+
+	serialNumber := uuid.NewString()
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	var document interface{}
+	if vs.format == model.SbomFormatSpdxJson {
+		namespace := fmt.Sprintf("%sapptrust/spdx/%s/%s-%s",
+			clientUtils.AddTrailingSlashIfNeeded(vs.serverDetails.Url), vs.applicationKey, version, serialNumber)
+		document = toSpdx(root, namespace, timestamp)
+	} else {
+		document = toCycloneDx(root, serialNumber, timestamp)
+	}
+
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+// buildApplicationComponent returns the component of an application version, including the versions it was created
+// from as nested application components. A version that was already visited is not added again, to avoid cycles.
+func (vs *versionSbomCommand) buildApplicationComponent(ctx service.Context, applicationKey, version string, visited map[string]bool) (*sbomComponent, error) {
+	visited[applicationKey+"@"+version] = true
+	content, err := vs.versionService.GetAppVersionContent(ctx, applicationKey, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var nestedComponents []*sbomComponent
+	nestedPurls := make(map[string]bool)
+	for _, reference := range content.Versions {
+		nestedKey := reference.ApplicationKey
+		if nestedKey == "" {
+			nestedKey = applicationKey
+		}
+		if visited[nestedKey+"@"+reference.Version] {
+			log.Debug("Skipping application version", nestedKey, reference.Version, "which is already in the SBOM.")
+			continue
+		}
+		nested, err := vs.buildApplicationComponent(ctx, nestedKey, reference.Version, visited)
+		if err != nil {
+			return nil, err
+		}
+		collectPurls(nested, nestedPurls)
+		nestedComponents = append(nestedComponents, nested)
+	}
+
+	return &sbomComponent{
+		kind:       model.CycloneDxComponentApplication,
+		name:       applicationKey,
+		version:    version,
+		components: append(buildPackageComponents(content.Releasables, nestedPurls), nestedComponents...),
+	}, nil
+}
+
+func (vs *versionSbomCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return vs.serverDetails, nil
+}
+
+func (vs *versionSbomCommand) CommandName() string {
+	return commands.VersionSbom
+}
+
+func (vs *versionSbomCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	vs.applicationKey = ctx.Arguments[0]
+	vs.version = ctx.Arguments[1]
+
+	var err error
+	vs.format, err = utils.ValidateEnumFlag(commands.FormatFlag, ctx.GetStringFlagValue(commands.FormatFlag),
+		model.SbomFormatCycloneDxJson, model.SbomFormatValues)
+	if err != nil {
+		return err
+	}
+
+	vs.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(vs)
+}
+
+func GetVersionSbomCommand(appContext app.Context) components.Command {
+	cmd := &versionSbomCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionSbom,
+		Description: "Export the packages and artifacts of an application version as a CycloneDX or SPDX SBOM.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vsb"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to export the SBOM of. " + versionSelectorsDescription,
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionSbom),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,102 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVersionSbomCommand_BuildApplicationComponent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.VersionContent{
+			Releasables: []model.Releasable{
+				{Name: "frontend", Version: "1.0.0", PackageType: "npm"},
+				{Name: "backend", Version: "2.0.0", PackageType: "npm"},
+			},
+			Versions: []model.CreateVersionReference{{ApplicationKey: "lib-app", Version: "2.0.0"}},
+		}, nil).Times(1)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "lib-app", "2.0.0").
+		Return(&model.VersionContent{
+			Releasables: []model.Releasable{{Name: "backend", Version: "2.0.0", PackageType: "npm"}},
+			Versions:    []model.CreateVersionReference{{ApplicationKey: "app-key", Version: "1.0.0"}},
+		}, nil).Times(1)
+
+	cmd := &versionSbomCommand{versionService: mockVersionService}
+	root, err := cmd.buildApplicationComponent(nil, "app-key", "1.0.0", make(map[string]bool))
+	require.NoError(t, err)
+
+	require.Len(t, root.components, 2)
+	assert.Equal(t, "pkg:npm/frontend@1.0.0", root.components[0].purl)
+	nested := root.components[1]
+	assert.Equal(t, model.CycloneDxComponentApplication, nested.kind)
+	assert.Equal(t, "lib-app", nested.name)
+	require.Len(t, nested.components, 1)
+	assert.Equal(t, "pkg:npm/backend@2.0.0", nested.components[0].purl)
+}
+
+func TestVersionSbomCommand_Run(t *testing.T) {
+	for _, format := range model.SbomFormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+				Return(&model.VersionContent{
+					Releasables: []model.Releasable{{Name: "frontend", Version: "1.0.0", PackageType: "npm"}},
+				}, nil).Times(1)
+
+			cmd := &versionSbomCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionSbomCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionContent(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("content error")).Times(1)
+
+	cmd := &versionSbomCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		format:         model.SbomFormatCycloneDxJson,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "content error")
+}
+
+func TestVersionSbomCommand_PrepareValidation(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+	ctx.AddStringFlag(commands.FormatFlag, model.OutputFormatJson)
+
+	cmd := &versionSbomCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --format: 'json'. Allowed values: cyclonedx-json and spdx-json")
+}
@@ -0,0 +1,145 @@
+package version
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	waitForCreated    = "created"
+	waitForPromoted   = "promoted"
+	waitForReleased   = "released"
+	waitForRolledBack = "rolled-back"
+
+	defaultWaitTimeout     = 30 * time.Minute
+	defaultInitialInterval = 2 * time.Second
+	defaultMaxInterval     = 30 * time.Second
+)
+
+// waitCondition is the state an application version is expected to reach.
+// The stage is only relevant for promotions and rollbacks.
+type waitCondition struct {
+	event string
+	stage string
+}
+
+// parseWaitCondition parses a condition in the form of created, promoted:<stage> or released.
+func parseWaitCondition(value string) (*waitCondition, error) {
+	event, stage, hasStage := strings.Cut(value, ":")
+	switch {
+	case event == waitForPromoted && stage != "":
+		return &waitCondition{event: event, stage: stage}, nil
+	case (event == waitForCreated || event == waitForReleased) && !hasStage:
+		return &waitCondition{event: event}, nil
+	default:
+		return nil, errorutils.CheckErrorf("invalid value for --%s: '%s'. Allowed values: %s, %s:<stage> and %s",
+			commands.ForFlag, value, waitForCreated, waitForPromoted, waitForReleased)
+	}
+}
+
+func (wc *waitCondition) String() string {
+	switch wc.event {
+	case waitForPromoted:
+		return "promoted to " + wc.stage
+	case waitForRolledBack:
+		return "rolled back from " + wc.stage
+	default:
+		return wc.event
+	}
+}
+
+// reached reports whether the version is in the expected state and no operation on it is still running.
+func (wc *waitCondition) reached(appVersion *model.AppVersion) bool {
+	if isPendingStatus(appVersion.Status) {
+		return false
+	}
+
+	switch wc.event {
+	case waitForPromoted:
+		return strings.EqualFold(appVersion.CurrentStage, wc.stage)
+	case waitForReleased:
+		return strings.EqualFold(appVersion.ReleaseStatus, model.ReleaseStatusReleased) ||
+			strings.EqualFold(appVersion.ReleaseStatus, model.ReleaseStatusTrustedRelease)
+	case waitForRolledBack:
+		return !strings.EqualFold(appVersion.CurrentStage, wc.stage)
+	default:
+		return true
+	}
+}
+
+func isPendingStatus(status string) bool {
+	return strings.EqualFold(status, model.VersionStatusStarted) || strings.EqualFold(status, model.VersionStatusInProgress)
+}
+
+// versionWaiter polls an application version with exponential backoff until it reaches a condition.
+type versionWaiter struct {
+	versionService  versions.VersionService
+	timeout         time.Duration
+	initialInterval time.Duration
+	maxInterval     time.Duration
+}
+
+func newVersionWaiter(versionService versions.VersionService, timeout time.Duration) *versionWaiter {
+	return &versionWaiter{
+		versionService:  versionService,
+		timeout:         timeout,
+		initialInterval: defaultInitialInterval,
+		maxInterval:     defaultMaxInterval,
+	}
+}
+
+func (vw *versionWaiter) wait(ctx service.Context, applicationKey, version string, condition *waitCondition) error {
+	log.Info("Waiting for version", version, "of application", applicationKey, "to be", condition.String()+"...")
+	deadline := time.Now().Add(vw.timeout)
+	interval := vw.initialInterval
+	for {
+		appVersion, err := vw.versionService.GetAppVersion(ctx, applicationKey, version)
+		switch {
+		case err == nil:
+			if strings.EqualFold(appVersion.Status, model.VersionStatusFailed) {
+				return errorutils.CheckErrorf("version '%s' of application '%s' failed to be %s",
+					version, applicationKey, condition.String())
+			}
+			if condition.reached(appVersion) {
+				log.Info("Version", version, "of application", applicationKey, "is", condition.String()+".")
+				return nil
+			}
+		case condition.event == waitForCreated && errors.Is(err, versions.ErrVersionNotFound):
+			// An asynchronously created version may not be visible yet.
+		default:
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return errorutils.CheckErrorf("timed out after %s waiting for version '%s' of application '%s' to be %s",
+				vw.timeout, version, applicationKey, condition.String())
+		}
+		log.Debug("Version", version, "is not", condition.String(), "yet. Checking again in", interval.String())
+		time.Sleep(min(interval, remaining))
+		interval = min(interval*2, vw.maxInterval)
+	}
+}
+
+// parseTimeoutFlag parses the --timeout flag as a duration, such as 90s or 30m.
+func parseTimeoutFlag(ctx *components.Context) (time.Duration, error) {
+	value := ctx.GetStringFlagValue(commands.TimeoutFlag)
+	if value == "" {
+		return defaultWaitTimeout, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a positive duration, such as 90s, 30m or 1h",
+			commands.TimeoutFlag, value)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,224 @@
+package version
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseWaitCondition(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  *waitCondition
+		expectErr bool
+	}{
+		{value: "created", expected: &waitCondition{event: waitForCreated}},
+		{value: "released", expected: &waitCondition{event: waitForReleased}},
+		{value: "promoted:QA", expected: &waitCondition{event: waitForPromoted, stage: "QA"}},
+		{value: "promoted", expectErr: true},
+		{value: "promoted:", expectErr: true},
+		{value: "created:QA", expectErr: true},
+		{value: "rolled-back:QA", expectErr: true},
+		{value: "deleted", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			condition, err := parseWaitCondition(tt.value)
+			if tt.expectErr {
+				assert.EqualError(t, err, fmt.Sprintf(
+					"invalid value for --for: '%s'. Allowed values: created, promoted:<stage> and released", tt.value))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, condition)
+		})
+	}
+}
+
+func TestWaitCondition_Reached(t *testing.T) {
+	tests := []struct {
+		name       string
+		condition  *waitCondition
+		appVersion *model.AppVersion
+		expected   bool
+	}{
+		{
+			name:       "created",
+			condition:  &waitCondition{event: waitForCreated},
+			appVersion: &model.AppVersion{Status: "COMPLETED"},
+			expected:   true,
+		},
+		{
+			name:       "created as draft",
+			condition:  &waitCondition{event: waitForCreated},
+			appVersion: &model.AppVersion{Status: "DRAFT"},
+			expected:   true,
+		},
+		{
+			name:       "creation in progress",
+			condition:  &waitCondition{event: waitForCreated},
+			appVersion: &model.AppVersion{Status: "IN_PROGRESS"},
+		},
+		{
+			name:       "promoted",
+			condition:  &waitCondition{event: waitForPromoted, stage: "QA"},
+			appVersion: &model.AppVersion{Status: "COMPLETED", CurrentStage: "qa"},
+			expected:   true,
+		},
+		{
+			name:       "promotion started",
+			condition:  &waitCondition{event: waitForPromoted, stage: "QA"},
+			appVersion: &model.AppVersion{Status: "STARTED", CurrentStage: "QA"},
+		},
+		{
+			name:       "promoted to another stage",
+			condition:  &waitCondition{event: waitForPromoted, stage: "QA"},
+			appVersion: &model.AppVersion{Status: "COMPLETED", CurrentStage: "DEV"},
+		},
+		{
+			name:       "released",
+			condition:  &waitCondition{event: waitForReleased},
+			appVersion: &model.AppVersion{Status: "COMPLETED", ReleaseStatus: "released"},
+			expected:   true,
+		},
+		{
+			name:       "trusted release",
+			condition:  &waitCondition{event: waitForReleased},
+			appVersion: &model.AppVersion{Status: "COMPLETED", ReleaseStatus: "trusted_release"},
+			expected:   true,
+		},
+		{
+			name:       "not released",
+			condition:  &waitCondition{event: waitForReleased},
+			appVersion: &model.AppVersion{Status: "COMPLETED", ReleaseStatus: "pre_release"},
+		},
+		{
+			name:       "rolled back",
+			condition:  &waitCondition{event: waitForRolledBack, stage: "PROD"},
+			appVersion: &model.AppVersion{Status: "COMPLETED", CurrentStage: "QA"},
+			expected:   true,
+		},
+		{
+			name:       "still in rolled back stage",
+			condition:  &waitCondition{event: waitForRolledBack, stage: "PROD"},
+			appVersion: &model.AppVersion{Status: "COMPLETED", CurrentStage: "PROD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.condition.reached(tt.appVersion))
+		})
+	}
+}
+
+func newTestVersionWaiter(versionService versions.VersionService, timeout time.Duration) *versionWaiter {
+	waiter := newVersionWaiter(versionService, timeout)
+	waiter.initialInterval = time.Millisecond
+	waiter.maxInterval = 4 * time.Millisecond
+	return waiter
+}
+
+func TestVersionWaiter_Wait(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(nil, fmt.Errorf("%w: \"app-key\" version \"1.0.0\"", versions.ErrVersionNotFound)),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "IN_PROGRESS"}, nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED"}, nil),
+	)
+
+	waiter := newTestVersionWaiter(mockVersionService, time.Minute)
+	err := waiter.wait(nil, "app-key", "1.0.0", &waitCondition{event: waitForCreated})
+	assert.NoError(t, err)
+}
+
+func TestVersionWaiter_Wait_Failed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	gomock.InOrder(
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "IN_PROGRESS", CurrentStage: "DEV"}, nil),
+		mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+			Return(&model.AppVersion{Version: "1.0.0", Status: "FAILED", CurrentStage: "DEV"}, nil),
+	)
+
+	waiter := newTestVersionWaiter(mockVersionService, time.Minute)
+	err := waiter.wait(nil, "app-key", "1.0.0", &waitCondition{event: waitForPromoted, stage: "QA"})
+	assert.EqualError(t, err, "version '1.0.0' of application 'app-key' failed to be promoted to QA")
+}
+
+func TestVersionWaiter_Wait_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notFoundErr := fmt.Errorf("%w: \"app-key\" version \"1.0.0\"", versions.ErrVersionNotFound)
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").Return(nil, notFoundErr).Times(1)
+
+	waiter := newTestVersionWaiter(mockVersionService, time.Minute)
+	err := waiter.wait(nil, "app-key", "1.0.0", &waitCondition{event: waitForReleased})
+	assert.ErrorIs(t, err, versions.ErrVersionNotFound)
+}
+
+func TestVersionWaiter_Wait_Timeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.AppVersion{Version: "1.0.0", Status: "STARTED"}, nil).MinTimes(2)
+
+	waiter := newTestVersionWaiter(mockVersionService, 20*time.Millisecond)
+	err := waiter.wait(nil, "app-key", "1.0.0", &waitCondition{event: waitForReleased})
+	assert.EqualError(t, err, "timed out after 20ms waiting for version '1.0.0' of application 'app-key' to be released")
+}
+
+func TestParseTimeoutFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{name: "default", expected: defaultWaitTimeout},
+		{name: "minutes", value: "10m", expected: 10 * time.Minute},
+		{name: "mixed", value: "1h30m", expected: 90 * time.Minute},
+		{name: "no unit", value: "30", expectErr: true},
+		{name: "negative", value: "-5m", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			if tt.value != "" {
+				ctx.AddStringFlag(commands.TimeoutFlag, tt.value)
+			}
+			timeout, err := parseTimeoutFlag(ctx)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid value for --timeout")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, timeout)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package version
+
+import (
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type waitAppVersionCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	applicationKey string
+	version        string
+	condition      *waitCondition
+	timeout        time.Duration
+}
+
+func (wv *waitAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*wv.serverDetails)
+	if err != nil {
+		return err
+	}
+
+	return newVersionWaiter(wv.versionService, wv.timeout).wait(ctx, wv.applicationKey, wv.version, wv.condition)
+}
+
+func (wv *waitAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return wv.serverDetails, nil
+}
+
+func (wv *waitAppVersionCommand) CommandName() string {
+	return commands.VersionWait
+}
+
+func (wv *waitAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	wv.applicationKey = ctx.Arguments[0]
+	wv.version = ctx.Arguments[1]
+
+	if err := utils.AssertValueProvided(ctx, commands.ForFlag); err != nil {
+		return err
+	}
+
+	var err error
+	wv.condition, err = parseWaitCondition(ctx.GetStringFlagValue(commands.ForFlag))
+	if err != nil {
+		return err
+	}
+
+	wv.timeout, err = parseTimeoutFlag(ctx)
+	if err != nil {
+		return err
+	}
+
+	wv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	return commonCLiCommands.Exec(wv)
+}
+
+func GetWaitAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &waitAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionWait,
+		Description: "Wait for an application version to be created, promoted or released.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vw"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to wait for.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionWait),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWaitAppVersionCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0").
+		Return(&model.AppVersion{Version: "1.0.0", Status: "COMPLETED", ReleaseStatus: "RELEASED"}, nil).Times(1)
+
+	cmd := &waitAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		condition:      &waitCondition{event: waitForReleased},
+		timeout:        time.Minute,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestWaitAppVersionCommand_MissingForFlag(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+
+	cmd := &waitAppVersionCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "the --for option is mandatory")
+}
+
+func TestWaitAppVersionCommand_InvalidTimeout(t *testing.T) {
+	ctx := &components.Context{Arguments: []string{"app-key", "1.0.0"}}
+	ctx.AddStringFlag(commands.ForFlag, "promoted:QA")
+	ctx.AddStringFlag(commands.TimeoutFlag, "soon")
+
+	cmd := &waitAppVersionCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "invalid value for --timeout: 'soon'. Expected a positive duration, such as 90s, 30m or 1h")
+}
+
+func TestWaitAppVersionCommand_WrongNumberOfArguments(t *testing.T) {
+	app := cli.NewApp()
+	set := flag.NewFlagSet("test", 0)
+	ctx := cli.NewContext(app, set, nil)
+
+	context, err := components.ConvertContext(ctx)
+	assert.NoError(t, err)
+
+	cmd := &waitAppVersionCommand{}
+	err = cmd.prepareAndRunCommand(context)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Wrong number of arguments")
+}
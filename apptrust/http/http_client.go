@@ -19,7 +19,10 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
 )
 
-const apptrustApiPath = "apptrust/api"
+const (
+	apptrustApiPath = "apptrust/api"
+	artifactoryPath = "artifactory/"
+)
 
 type ApptrustHttpClient interface {
 	GetHttpClient() *jfroghttpclient.JfrogHttpClient
@@ -27,6 +30,7 @@ type ApptrustHttpClient interface {
 	Get(path string, params map[string]string) (resp *http.Response, body []byte, err error)
 	Patch(path string, requestBody interface{}) (resp *http.Response, body []byte, err error)
 	Delete(path string, params map[string]string) (resp *http.Response, body []byte, err error)
+	DownloadArtifact(path string, offset int64) (resp *http.Response, err error)
 }
 
 type apptrustHttpClient struct {
@@ -149,6 +153,24 @@ func (c *apptrustHttpClient) Delete(path string, params map[string]string) (resp
 	return c.client.SendDelete(url, nil, c.getJsonHttpClientDetails())
 }
 
+// DownloadArtifact requests the content of an artifact from Artifactory, given its path including the repository key.
+// If offset is positive, only the content starting at offset is requested. The caller must close the response body.
+func (c *apptrustHttpClient) DownloadArtifact(path string, offset int64) (resp *http.Response, err error) {
+	url, err := utils.BuildUrl(c.serverDetails.Url, artifactoryPath+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClientDetails := c.authDetails.CreateHttpClientDetails()
+	if offset > 0 {
+		httpClientDetails.AddHeader("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	log.Debug("Sending GET request to:", url)
+	resp, _, _, err = c.client.Send(http.MethodGet, url, nil, true, false, &httpClientDetails, "")
+	return resp, err
+}
+
 func (c *apptrustHttpClient) getJsonHttpClientDetails() *httputils.HttpClientDetails {
 	httpClientDetails := c.authDetails.CreateHttpClientDetails()
 	httpClientDetails.SetContentTypeApplicationJson()
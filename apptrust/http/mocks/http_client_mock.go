@@ -57,6 +57,21 @@ func (mr *MockApptrustHttpClientMockRecorder) Delete(path, params any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApptrustHttpClient)(nil).Delete), path, params)
 }
 
+// DownloadArtifact mocks base method.
+func (m *MockApptrustHttpClient) DownloadArtifact(path string, offset int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadArtifact", path, offset)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadArtifact indicates an expected call of DownloadArtifact.
+func (mr *MockApptrustHttpClientMockRecorder) DownloadArtifact(path, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadArtifact", reflect.TypeOf((*MockApptrustHttpClient)(nil).DownloadArtifact), path, offset)
+}
+
 // Get mocks base method.
 func (m *MockApptrustHttpClient) Get(path string, params map[string]string) (*http.Response, []byte, error) {
 	m.ctrl.T.Helper()
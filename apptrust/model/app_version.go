@@ -0,0 +1,63 @@
+package model
+
+type AppVersion struct {
+	ApplicationKey string              `json:"application_key,omitempty"`
+	Version        string              `json:"version"`
+	Tag            string              `json:"tag,omitempty"`
+	Status         string              `json:"status,omitempty"`
+	ReleaseStatus  string              `json:"release_status,omitempty"`
+	CurrentStage   string              `json:"current_stage,omitempty"`
+	Properties     map[string][]string `json:"properties,omitempty"`
+	CreatedBy      string              `json:"created_by,omitempty"`
+	Created        string              `json:"created,omitempty"`
+}
+
+type ListAppVersionsResponse struct {
+	Versions []AppVersion `json:"versions"`
+	Total    int          `json:"total"`
+	Limit    int          `json:"limit"`
+	Offset   int          `json:"offset"`
+}
+
+const (
+	VersionStatusDraft      = "DRAFT"
+	VersionStatusStarted    = "STARTED"
+	VersionStatusInProgress = "IN_PROGRESS"
+	VersionStatusCompleted  = "COMPLETED"
+	VersionStatusFailed     = "FAILED"
+
+	ReleaseStatusReleased       = "RELEASED"
+	ReleaseStatusTrustedRelease = "TRUSTED_RELEASE"
+)
+
+const (
+	VersionSortByCreated = "created"
+	VersionSortBySemver  = "semver"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+const (
+	VersionBumpMajor      = "major"
+	VersionBumpMinor      = "minor"
+	VersionBumpPatch      = "patch"
+	VersionBumpPreRelease = "prerelease"
+)
+
+var VersionBumpValues = []string{
+	VersionBumpMajor,
+	VersionBumpMinor,
+	VersionBumpPatch,
+	VersionBumpPreRelease,
+}
+
+var VersionSortByValues = []string{
+	VersionSortByCreated,
+	VersionSortBySemver,
+}
+
+var SortOrderValues = []string{
+	SortOrderAsc,
+	SortOrderDesc,
+}
@@ -0,0 +1,46 @@
+package model
+
+const (
+	InTotoStatementType = "https://in-toto.io/Statement/v1"
+	InTotoPayloadType   = "application/vnd.in-toto+json"
+	InTotoDigestSha256  = "sha256"
+)
+
+// InTotoStatement is an in-toto attestation statement about one or more subjects.
+type InTotoStatement struct {
+	Type          string          `json:"_type"`
+	Subject       []InTotoSubject `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     interface{}     `json:"predicate"`
+}
+
+type InTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// DsseEnvelope wraps a base64-encoded payload together with its signatures.
+// An envelope with no signatures is unsigned.
+type DsseEnvelope struct {
+	PayloadType string          `json:"payloadType"`
+	Payload     string          `json:"payload"`
+	Signatures  []DsseSignature `json:"signatures"`
+}
+
+type DsseSignature struct {
+	KeyId string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+type Evidence struct {
+	Id            string `json:"id,omitempty"`
+	PredicateType string `json:"predicate_type"`
+	CreatedBy     string `json:"created_by,omitempty"`
+	Created       string `json:"created,omitempty"`
+	Signed        bool   `json:"signed"`
+	KeyId         string `json:"key_id,omitempty"`
+}
+
+type ListEvidenceResponse struct {
+	Evidence []Evidence `json:"evidence"`
+}
@@ -0,0 +1,8 @@
+package model
+
+type ListApplicationsResponse struct {
+	Applications []AppDescriptor `json:"applications"`
+	Total        int             `json:"total"`
+	Limit        int             `json:"limit"`
+	Offset       int             `json:"offset"`
+}
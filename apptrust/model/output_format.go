@@ -0,0 +1,19 @@
+package model
+
+const (
+	OutputFormatTable = "table"
+	OutputFormatJson  = "json"
+	OutputFormatCsv   = "csv"
+)
+
+var OutputFormatValues = []string{
+	OutputFormatTable,
+	OutputFormatJson,
+}
+
+// VersionContentFormatValues are the output formats of the version-content command, which can also export the content as CSV.
+var VersionContentFormatValues = []string{
+	OutputFormatTable,
+	OutputFormatJson,
+	OutputFormatCsv,
+}
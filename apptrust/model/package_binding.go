@@ -0,0 +1,20 @@
+package model
+
+type PackageBinding struct {
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	NumVersions   int    `json:"num_versions,omitempty"`
+	LatestVersion string `json:"latest_version,omitempty"`
+}
+
+type ListPackageBindingsResponse struct {
+	Packages []PackageBinding `json:"packages"`
+}
+
+type PackageBindingVersion struct {
+	Version string `json:"version"`
+}
+
+type PackageBindingVersionsResponse struct {
+	Versions []PackageBindingVersion `json:"versions"`
+}
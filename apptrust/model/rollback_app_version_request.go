@@ -5,9 +5,7 @@ type RollbackAppVersionRequest struct {
 }
 
 type RollbackAppVersionResponse struct {
-	ApplicationKey    string `json:"application_key"`
-	Version           string `json:"version"`
-	ProjectKey        string `json:"project_key"`
+	AppVersionReference
 	RollbackFromStage string `json:"rollback_from_stage"`
 	RollbackToStage   string `json:"rollback_to_stage"`
 }
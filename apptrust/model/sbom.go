@@ -0,0 +1,121 @@
+package model
+
+const (
+	SbomFormatCycloneDxJson = "cyclonedx-json"
+	SbomFormatSpdxJson      = "spdx-json"
+)
+
+var SbomFormatValues = []string{
+	SbomFormatCycloneDxJson,
+	SbomFormatSpdxJson,
+}
+
+const (
+	CycloneDxBomFormat   = "CycloneDX"
+	CycloneDxSpecVersion = "1.5"
+
+	CycloneDxComponentApplication = "application"
+	CycloneDxComponentLibrary     = "library"
+	CycloneDxComponentFile        = "file"
+
+	CycloneDxHashSha256 = "SHA-256"
+)
+
+// CycloneDxBom is a CycloneDX JSON document. Nested components are contained in their parent component.
+type CycloneDxBom struct {
+	BomFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     CycloneDxMetadata    `json:"metadata"`
+	Components   []CycloneDxComponent `json:"components"`
+}
+
+type CycloneDxMetadata struct {
+	Timestamp string              `json:"timestamp"`
+	Tools     *CycloneDxTools     `json:"tools,omitempty"`
+	Component *CycloneDxComponent `json:"component,omitempty"`
+}
+
+type CycloneDxTools struct {
+	Components []CycloneDxComponent `json:"components"`
+}
+
+type CycloneDxComponent struct {
+	Type       string               `json:"type"`
+	BomRef     string               `json:"bom-ref,omitempty"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version,omitempty"`
+	Purl       string               `json:"purl,omitempty"`
+	Hashes     []CycloneDxHash      `json:"hashes,omitempty"`
+	Components []CycloneDxComponent `json:"components,omitempty"`
+}
+
+type CycloneDxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+const (
+	SpdxVersion        = "SPDX-2.3"
+	SpdxDataLicense    = "CC0-1.0"
+	SpdxDocumentId     = "SPDXRef-DOCUMENT"
+	SpdxNoAssertion    = "NOASSERTION"
+	SpdxChecksumSha256 = "SHA256"
+
+	SpdxPurposeApplication = "APPLICATION"
+	SpdxPurposeLibrary     = "LIBRARY"
+	SpdxPurposeFile        = "FILE"
+
+	SpdxRelationshipDescribes = "DESCRIBES"
+	SpdxRelationshipContains  = "CONTAINS"
+
+	SpdxReferenceCategoryPackageManager = "PACKAGE-MANAGER"
+	SpdxReferenceTypePurl               = "purl"
+)
+
+// SpdxDocument is an SPDX JSON document. Nesting is expressed by CONTAINS relationships between packages.
+type SpdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SpdxId            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      SpdxCreationInfo   `json:"creationInfo"`
+	DocumentDescribes []string           `json:"documentDescribes"`
+	Packages          []SpdxPackage      `json:"packages"`
+	Relationships     []SpdxRelationship `json:"relationships"`
+}
+
+type SpdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type SpdxPackage struct {
+	SpdxId                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+	Checksums             []SpdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs          []SpdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type SpdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type SpdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type SpdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
@@ -0,0 +1,26 @@
+package model
+
+type VersionContent struct {
+	ApplicationKey string                   `json:"application_key"`
+	Version        string                   `json:"version"`
+	Status         string                   `json:"status,omitempty"`
+	CurrentStage   string                   `json:"current_stage,omitempty"`
+	Tag            string                   `json:"tag,omitempty"`
+	Releasables    []Releasable             `json:"releasables"`
+	Versions       []CreateVersionReference `json:"versions,omitempty"`
+}
+
+type Releasable struct {
+	Name          string               `json:"name"`
+	Version       string               `json:"version"`
+	PackageType   string               `json:"package_type"`
+	RepositoryKey string               `json:"repository_key,omitempty"`
+	Sha256        string               `json:"sha256,omitempty"`
+	Artifacts     []ReleasableArtifact `json:"artifacts,omitempty"`
+}
+
+type ReleasableArtifact struct {
+	Path   string `json:"path"`
+	Sha256 string `json:"sha256,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+}
@@ -0,0 +1,31 @@
+package model
+
+// AppVersionReference identifies an application version in responses that describe an operation on it.
+type AppVersionReference struct {
+	ApplicationKey string `json:"application_key"`
+	Version        string `json:"version"`
+	ProjectKey     string `json:"project_key"`
+}
+
+const (
+	VersionEventPromotion = "promotion"
+	VersionEventRelease   = "release"
+	VersionEventRollback  = "rollback"
+)
+
+// AppVersionHistory lists the promotions, releases and rollbacks of an application version.
+type AppVersionHistory struct {
+	AppVersionReference
+	Events []VersionHistoryEvent `json:"events"`
+}
+
+// VersionHistoryEvent is a single operation that moved a version between stages.
+// The from stage is empty for the first promotion, and the to stage is empty for a rollback out of the first stage.
+type VersionHistoryEvent struct {
+	EventType string `json:"event_type"`
+	Status    string `json:"status"`
+	FromStage string `json:"from_stage,omitempty"`
+	ToStage   string `json:"to_stage,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	Created   string `json:"created,omitempty"`
+}
@@ -3,6 +3,8 @@ package applications
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,8 +15,13 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 )
 
+// ErrApplicationNotFound is returned by GetApplication when the requested application does not exist.
+var ErrApplicationNotFound = errors.New("application not found")
+
 type ApplicationService interface {
 	CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) error
+	GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error)
+	ListApplications(ctx service.Context, params map[string]string) (*model.ListApplicationsResponse, error)
 	UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error
 	DeleteApplication(ctx service.Context, applicationKey string) error
 }
@@ -41,6 +48,47 @@ func (as *applicationService) CreateApplication(ctx service.Context, requestBody
 	return nil
 }
 
+func (as *applicationService) GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s", applicationKey)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\"", ErrApplicationNotFound, applicationKey))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("failed to get application. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	appDescriptor := new(model.AppDescriptor)
+	if err = json.Unmarshal(responseBody, appDescriptor); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return appDescriptor, nil
+}
+
+func (as *applicationService) ListApplications(ctx service.Context, params map[string]string) (*model.ListApplicationsResponse, error) {
+	response, responseBody, err := ctx.GetHttpClient().Get("/v1/applications", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("failed to list applications. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	listResponse := new(model.ListApplicationsResponse)
+	if err = json.Unmarshal(responseBody, listResponse); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return listResponse, nil
+}
+
 func (as *applicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
 	endpoint := fmt.Sprintf("/v1/applications/%s", requestBody.ApplicationKey)
 	response, responseBody, err := ctx.GetHttpClient().Patch(endpoint, requestBody)
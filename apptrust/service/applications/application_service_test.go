@@ -65,3 +65,136 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationService_GetApplication(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  *http.Response
+		mockBody      []byte
+		mockError     error
+		expected      *model.AppDescriptor
+		expectedError string
+	}{
+		{
+			name:         "GetApplication successful",
+			mockResponse: &http.Response{StatusCode: http.StatusOK},
+			mockBody:     []byte(`{"application_key":"app-123","project_key":"proj","criticality":"high","user_owners":["admin"]}`),
+			expected: &model.AppDescriptor{
+				ApplicationKey:      "app-123",
+				ProjectKey:          "proj",
+				BusinessCriticality: stringPtr("high"),
+				UserOwners:          &[]string{"admin"},
+			},
+		},
+		{
+			name:          "GetApplication failed with not found",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      []byte("not found"),
+			expectedError: "application not found: \"app-123\"",
+		},
+		{
+			name:          "GetApplication failed with non-200 status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusForbidden},
+			mockBody:      []byte("forbidden"),
+			expectedError: "failed to get application. Status code: 403.\nforbidden",
+		},
+		{
+			name:          "GetApplication failed with invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("{invalid"),
+			expectedError: "invalid character",
+		},
+		{
+			name:          "GetApplication failed with error",
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/app-123", nil).Return(tt.mockResponse, tt.mockBody, tt.mockError)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			as := NewApplicationService()
+			appDescriptor, err := as.GetApplication(mockCtx, "app-123")
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.Nil(t, appDescriptor)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, appDescriptor)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestApplicationService_ListApplications(t *testing.T) {
+	params := map[string]string{"project_key": "proj", "limit": "2", "offset": "0"}
+
+	tests := []struct {
+		name          string
+		mockResponse  *http.Response
+		mockBody      []byte
+		mockError     error
+		expected      *model.ListApplicationsResponse
+		expectedError string
+	}{
+		{
+			name:         "ListApplications successful",
+			mockResponse: &http.Response{StatusCode: http.StatusOK},
+			mockBody:     []byte(`{"applications":[{"application_key":"app-1"},{"application_key":"app-2"}],"total":3,"limit":2,"offset":0}`),
+			expected: &model.ListApplicationsResponse{
+				Applications: []model.AppDescriptor{{ApplicationKey: "app-1"}, {ApplicationKey: "app-2"}},
+				Total:        3,
+				Limit:        2,
+			},
+		},
+		{
+			name:          "ListApplications failed with non-200 status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusBadRequest},
+			mockBody:      []byte("bad request"),
+			expectedError: "failed to list applications. Status code: 400.\nbad request",
+		},
+		{
+			name:          "ListApplications failed with error",
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications", params).Return(tt.mockResponse, tt.mockBody, tt.mockError)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			as := NewApplicationService()
+			listResponse, err := as.ListApplications(mockCtx, params)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, listResponse)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, listResponse)
+			}
+		})
+	}
+}
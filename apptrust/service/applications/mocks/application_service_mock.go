@@ -69,6 +69,36 @@ func (mr *MockApplicationServiceMockRecorder) DeleteApplication(ctx, application
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockApplicationService)(nil).DeleteApplication), ctx, applicationKey)
 }
 
+// GetApplication mocks base method.
+func (m *MockApplicationService) GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", ctx, applicationKey)
+	ret0, _ := ret[0].(*model.AppDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockApplicationServiceMockRecorder) GetApplication(ctx, applicationKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockApplicationService)(nil).GetApplication), ctx, applicationKey)
+}
+
+// ListApplications mocks base method.
+func (m *MockApplicationService) ListApplications(ctx service.Context, params map[string]string) (*model.ListApplicationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications", ctx, params)
+	ret0, _ := ret[0].(*model.ListApplicationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockApplicationServiceMockRecorder) ListApplications(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationService)(nil).ListApplications), ctx, params)
+}
+
 // UpdateApplication mocks base method.
 func (m *MockApplicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,52 @@
+package artifacts
+
+//go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type ArtifactService interface {
+	// DownloadArtifact returns the content of an artifact starting at offset, and the offset the content actually starts at.
+	// The returned offset is 0 if the server does not support resuming. The caller must close the returned reader.
+	DownloadArtifact(ctx service.Context, path string, offset int64) (io.ReadCloser, int64, error)
+}
+
+type artifactService struct{}
+
+func NewArtifactService() ArtifactService {
+	return &artifactService{}
+}
+
+func (as *artifactService) DownloadArtifact(ctx service.Context, path string, offset int64) (io.ReadCloser, int64, error) {
+	response, err := ctx.GetHttpClient().DownloadArtifact(path, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch {
+	case response.StatusCode == http.StatusOK:
+		return response.Body, 0, nil
+	case response.StatusCode == http.StatusPartialContent && offset > 0:
+		return response.Body, offset, nil
+	case response.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The partial content is not a prefix of the artifact, so download it from the start.
+		_ = response.Body.Close()
+		return as.DownloadArtifact(ctx, path, 0)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, 0, errorutils.CheckError(err)
+	}
+	return nil, 0, fmt.Errorf("failed to download artifact %s. Status code: %d.\n%s",
+		path, response.StatusCode, responseBody)
+}
@@ -0,0 +1,105 @@
+package artifacts
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
+	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestArtifactService_DownloadArtifact(t *testing.T) {
+	tests := []struct {
+		name           string
+		offset         int64
+		responses      []*http.Response
+		mockError      error
+		expectedOffset int64
+		expectedBody   string
+		expectedError  string
+	}{
+		{
+			name:         "full download",
+			responses:    []*http.Response{newResponse(http.StatusOK, "content")},
+			expectedBody: "content",
+		},
+		{
+			name:           "resumed download",
+			offset:         3,
+			responses:      []*http.Response{newResponse(http.StatusPartialContent, "tent")},
+			expectedOffset: 3,
+			expectedBody:   "tent",
+		},
+		{
+			name:         "range ignored by the server",
+			offset:       3,
+			responses:    []*http.Response{newResponse(http.StatusOK, "content")},
+			expectedBody: "content",
+		},
+		{
+			name:   "range not satisfiable",
+			offset: 100,
+			responses: []*http.Response{
+				newResponse(http.StatusRequestedRangeNotSatisfiable, ""),
+				newResponse(http.StatusOK, "content"),
+			},
+			expectedBody: "content",
+		},
+		{
+			name:          "not found",
+			responses:     []*http.Response{newResponse(http.StatusNotFound, "not found")},
+			expectedError: "failed to download artifact repo/file.txt. Status code: 404.\nnot found",
+		},
+		{
+			name:          "http error",
+			responses:     []*http.Response{nil},
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().DownloadArtifact("repo/file.txt", tt.offset).
+				Return(tt.responses[0], tt.mockError)
+			if len(tt.responses) > 1 {
+				mockHttpClient.EXPECT().DownloadArtifact("repo/file.txt", int64(0)).
+					Return(tt.responses[1], nil)
+			}
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(len(tt.responses))
+
+			as := NewArtifactService()
+			body, offset, err := as.DownloadArtifact(mockCtx, "repo/file.txt", tt.offset)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, body)
+				return
+			}
+			require.NoError(t, err)
+			defer func() {
+				_ = body.Close()
+			}()
+			assert.Equal(t, tt.expectedOffset, offset)
+			content, err := io.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBody, string(content))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: artifact_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=artifact_service.go -destination=mocks/artifact_service_mock.go
+//
+
+// Package mock_artifacts is a generated GoMock package.
+package mock_artifacts
+
+import (
+	io "io"
+	reflect "reflect"
+
+	service "github.com/jfrog/jfrog-cli-application/apptrust/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArtifactService is a mock of ArtifactService interface.
+type MockArtifactService struct {
+	ctrl     *gomock.Controller
+	recorder *MockArtifactServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockArtifactServiceMockRecorder is the mock recorder for MockArtifactService.
+type MockArtifactServiceMockRecorder struct {
+	mock *MockArtifactService
+}
+
+// NewMockArtifactService creates a new mock instance.
+func NewMockArtifactService(ctrl *gomock.Controller) *MockArtifactService {
+	mock := &MockArtifactService{ctrl: ctrl}
+	mock.recorder = &MockArtifactServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArtifactService) EXPECT() *MockArtifactServiceMockRecorder {
+	return m.recorder
+}
+
+// DownloadArtifact mocks base method.
+func (m *MockArtifactService) DownloadArtifact(ctx service.Context, path string, offset int64) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadArtifact", ctx, path, offset)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadArtifact indicates an expected call of DownloadArtifact.
+func (mr *MockArtifactServiceMockRecorder) DownloadArtifact(ctx, path, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadArtifact", reflect.TypeOf((*MockArtifactService)(nil).DownloadArtifact), ctx, path, offset)
+}
@@ -55,6 +55,36 @@ func (mr *MockPackageServiceMockRecorder) BindPackage(ctx, applicationKey, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindPackage", reflect.TypeOf((*MockPackageService)(nil).BindPackage), ctx, applicationKey, request)
 }
 
+// GetPackageBindingVersions mocks base method.
+func (m *MockPackageService) GetPackageBindingVersions(ctx service.Context, applicationKey, pkgType, pkgName string) (*model.PackageBindingVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageBindingVersions", ctx, applicationKey, pkgType, pkgName)
+	ret0, _ := ret[0].(*model.PackageBindingVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageBindingVersions indicates an expected call of GetPackageBindingVersions.
+func (mr *MockPackageServiceMockRecorder) GetPackageBindingVersions(ctx, applicationKey, pkgType, pkgName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageBindingVersions", reflect.TypeOf((*MockPackageService)(nil).GetPackageBindingVersions), ctx, applicationKey, pkgType, pkgName)
+}
+
+// ListPackageBindings mocks base method.
+func (m *MockPackageService) ListPackageBindings(ctx service.Context, applicationKey string) (*model.ListPackageBindingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackageBindings", ctx, applicationKey)
+	ret0, _ := ret[0].(*model.ListPackageBindingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackageBindings indicates an expected call of ListPackageBindings.
+func (mr *MockPackageServiceMockRecorder) ListPackageBindings(ctx, applicationKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackageBindings", reflect.TypeOf((*MockPackageService)(nil).ListPackageBindings), ctx, applicationKey)
+}
+
 // UnbindPackage mocks base method.
 func (m *MockPackageService) UnbindPackage(ctx service.Context, applicationKey, pkgType, pkgName, pkgVersion string) error {
 	m.ctrl.T.Helper()
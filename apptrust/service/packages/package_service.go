@@ -3,18 +3,22 @@ package packages
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type PackageService interface {
 	BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) error
 	UnbindPackage(ctx service.Context, applicationKey, pkgType, pkgName, pkgVersion string) error
+	ListPackageBindings(ctx service.Context, applicationKey string) (*model.ListPackageBindingsResponse, error)
+	GetPackageBindingVersions(ctx service.Context, applicationKey, pkgType, pkgName string) (*model.PackageBindingVersionsResponse, error)
 }
 
 type packageService struct{}
@@ -55,3 +59,41 @@ func (ps *packageService) UnbindPackage(ctx service.Context, applicationKey, pkg
 	log.Info("Package unbound successfully.")
 	return nil
 }
+
+func (ps *packageService) ListPackageBindings(ctx service.Context, applicationKey string) (*model.ListPackageBindingsResponse, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/packages", applicationKey)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list bound packages. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	bindings := new(model.ListPackageBindingsResponse)
+	if err = json.Unmarshal(responseBody, bindings); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return bindings, nil
+}
+
+func (ps *packageService) GetPackageBindingVersions(ctx service.Context, applicationKey, pkgType, pkgName string) (*model.PackageBindingVersionsResponse, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/packages/%s/%s", applicationKey, pkgType, url.PathEscape(pkgName))
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get bound package versions. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	versions := new(model.PackageBindingVersionsResponse)
+	if err = json.Unmarshal(responseBody, versions); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return versions, nil
+}
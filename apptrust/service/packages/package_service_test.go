@@ -157,3 +157,114 @@ func TestUnbindPackage(t *testing.T) {
 		})
 	}
 }
+
+func TestListPackageBindings(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.ListPackageBindingsResponse
+		expectedError    string
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: 200},
+			mockResponseBody: `{"packages":[{"type":"npm","name":"test-package","num_versions":2,"latest_version":"1.0.1"}]}`,
+			expected: &model.ListPackageBindingsResponse{
+				Packages: []model.PackageBinding{{Type: "npm", Name: "test-package", NumVersions: 2, LatestVersion: "1.0.1"}},
+			},
+		},
+		{
+			name:          "failed with non-200 status code",
+			mockResponse:  &http.Response{StatusCode: 400},
+			expectedError: "failed to list bound packages. Status code: 400",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/packages", nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			bindings, err := NewPackageService().ListPackageBindings(mockCtx, "test-app")
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, bindings)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestGetPackageBindingVersions(t *testing.T) {
+	tests := []struct {
+		name             string
+		pkgName          string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.PackageBindingVersionsResponse
+		expectedError    string
+	}{
+		{
+			name:             "success with special characters in package name",
+			pkgName:          "@test/package",
+			mockResponse:     &http.Response{StatusCode: 200},
+			mockResponseBody: `{"versions":[{"version":"1.0.0"},{"version":"1.0.1"}]}`,
+			expected: &model.PackageBindingVersionsResponse{
+				Versions: []model.PackageBindingVersion{{Version: "1.0.0"}, {Version: "1.0.1"}},
+			},
+		},
+		{
+			name:          "failed with non-200 status code",
+			pkgName:       "test-package",
+			mockResponse:  &http.Response{StatusCode: 404},
+			expectedError: "failed to get bound package versions. Status code: 404",
+		},
+		{
+			name:          "http client error",
+			pkgName:       "test-package",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedEndpoint := fmt.Sprintf("/v1/applications/test-app/packages/npm/%s", url.PathEscape(tt.pkgName))
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get(expectedEndpoint, nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			versions, err := NewPackageService().GetPackageBindingVersions(mockCtx, "test-app", "npm", tt.pkgName)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, versions)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
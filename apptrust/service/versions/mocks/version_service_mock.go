@@ -41,6 +41,21 @@ func (m *MockVersionService) EXPECT() *MockVersionServiceMockRecorder {
 	return m.recorder
 }
 
+// AddAppVersionEvidence mocks base method.
+func (m *MockVersionService) AddAppVersionEvidence(ctx service.Context, applicationKey, version string, envelope *model.DsseEnvelope) (*model.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAppVersionEvidence", ctx, applicationKey, version, envelope)
+	ret0, _ := ret[0].(*model.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAppVersionEvidence indicates an expected call of AddAppVersionEvidence.
+func (mr *MockVersionServiceMockRecorder) AddAppVersionEvidence(ctx, applicationKey, version, envelope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAppVersionEvidence", reflect.TypeOf((*MockVersionService)(nil).AddAppVersionEvidence), ctx, applicationKey, version, envelope)
+}
+
 // CreateAppVersion mocks base method.
 func (m *MockVersionService) CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync bool) error {
 	m.ctrl.T.Helper()
@@ -69,6 +84,81 @@ func (mr *MockVersionServiceMockRecorder) DeleteAppVersion(ctx, applicationKey,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppVersion", reflect.TypeOf((*MockVersionService)(nil).DeleteAppVersion), ctx, applicationKey, version)
 }
 
+// GetAppVersion mocks base method.
+func (m *MockVersionService) GetAppVersion(ctx service.Context, applicationKey, version string) (*model.AppVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppVersion", ctx, applicationKey, version)
+	ret0, _ := ret[0].(*model.AppVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppVersion indicates an expected call of GetAppVersion.
+func (mr *MockVersionServiceMockRecorder) GetAppVersion(ctx, applicationKey, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppVersion", reflect.TypeOf((*MockVersionService)(nil).GetAppVersion), ctx, applicationKey, version)
+}
+
+// GetAppVersionContent mocks base method.
+func (m *MockVersionService) GetAppVersionContent(ctx service.Context, applicationKey, version string) (*model.VersionContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppVersionContent", ctx, applicationKey, version)
+	ret0, _ := ret[0].(*model.VersionContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppVersionContent indicates an expected call of GetAppVersionContent.
+func (mr *MockVersionServiceMockRecorder) GetAppVersionContent(ctx, applicationKey, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppVersionContent", reflect.TypeOf((*MockVersionService)(nil).GetAppVersionContent), ctx, applicationKey, version)
+}
+
+// GetAppVersionHistory mocks base method.
+func (m *MockVersionService) GetAppVersionHistory(ctx service.Context, applicationKey, version string) (*model.AppVersionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppVersionHistory", ctx, applicationKey, version)
+	ret0, _ := ret[0].(*model.AppVersionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppVersionHistory indicates an expected call of GetAppVersionHistory.
+func (mr *MockVersionServiceMockRecorder) GetAppVersionHistory(ctx, applicationKey, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppVersionHistory", reflect.TypeOf((*MockVersionService)(nil).GetAppVersionHistory), ctx, applicationKey, version)
+}
+
+// ListAppVersionEvidence mocks base method.
+func (m *MockVersionService) ListAppVersionEvidence(ctx service.Context, applicationKey, version string) (*model.ListEvidenceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppVersionEvidence", ctx, applicationKey, version)
+	ret0, _ := ret[0].(*model.ListEvidenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppVersionEvidence indicates an expected call of ListAppVersionEvidence.
+func (mr *MockVersionServiceMockRecorder) ListAppVersionEvidence(ctx, applicationKey, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppVersionEvidence", reflect.TypeOf((*MockVersionService)(nil).ListAppVersionEvidence), ctx, applicationKey, version)
+}
+
+// ListAppVersions mocks base method.
+func (m *MockVersionService) ListAppVersions(ctx service.Context, applicationKey string, params map[string]string) (*model.ListAppVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppVersions", ctx, applicationKey, params)
+	ret0, _ := ret[0].(*model.ListAppVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppVersions indicates an expected call of ListAppVersions.
+func (mr *MockVersionServiceMockRecorder) ListAppVersions(ctx, applicationKey, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppVersions", reflect.TypeOf((*MockVersionService)(nil).ListAppVersions), ctx, applicationKey, params)
+}
+
 // PromoteAppVersion mocks base method.
 func (m *MockVersionService) PromoteAppVersion(ctx service.Context, applicationKey, version string, payload *model.PromoteAppVersionRequest, sync bool) error {
 	m.ctrl.T.Helper()
@@ -98,11 +188,12 @@ func (mr *MockVersionServiceMockRecorder) ReleaseAppVersion(ctx, applicationKey,
 }
 
 // RollbackAppVersion mocks base method.
-func (m *MockVersionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) error {
+func (m *MockVersionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackAppVersion", ctx, applicationKey, version, request, sync)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.RollbackAppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RollbackAppVersion indicates an expected call of RollbackAppVersion.
@@ -3,23 +3,35 @@ package versions
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 )
 
+// ErrVersionNotFound is returned by GetAppVersion when the requested application version does not exist.
+var ErrVersionNotFound = errors.New("application version not found")
+
 type VersionService interface {
 	CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync bool) error
 	PromoteAppVersion(ctx service.Context, applicationKey string, version string, payload *model.PromoteAppVersionRequest, sync bool) error
 	ReleaseAppVersion(ctx service.Context, applicationKey string, version string, request *model.ReleaseAppVersionRequest, sync bool) error
-	RollbackAppVersion(ctx service.Context, applicationKey string, version string, request *model.RollbackAppVersionRequest, sync bool) error
+	RollbackAppVersion(ctx service.Context, applicationKey string, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error)
 	DeleteAppVersion(ctx service.Context, applicationKey string, version string) error
 	UpdateAppVersion(ctx service.Context, applicationKey string, version string, request *model.UpdateAppVersionRequest) error
+	ListAppVersions(ctx service.Context, applicationKey string, params map[string]string) (*model.ListAppVersionsResponse, error)
+	GetAppVersion(ctx service.Context, applicationKey string, version string) (*model.AppVersion, error)
+	GetAppVersionContent(ctx service.Context, applicationKey string, version string) (*model.VersionContent, error)
+	GetAppVersionHistory(ctx service.Context, applicationKey string, version string) (*model.AppVersionHistory, error)
+	AddAppVersionEvidence(ctx service.Context, applicationKey string, version string, envelope *model.DsseEnvelope) (*model.Evidence, error)
+	ListAppVersionEvidence(ctx service.Context, applicationKey string, version string) (*model.ListEvidenceResponse, error)
 }
 
 type versionService struct{}
@@ -82,11 +94,12 @@ func (vs *versionService) ReleaseAppVersion(ctx service.Context, applicationKey,
 	return nil
 }
 
-func (vs *versionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) error {
+// RollbackAppVersion returns the decoded response, or nil if the server did not return a response body.
+func (vs *versionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/rollback", applicationKey, version)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request, map[string]string{"async": strconv.FormatBool(!sync)})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Validate status code based on sync mode
@@ -96,12 +109,19 @@ func (vs *versionService) RollbackAppVersion(ctx service.Context, applicationKey
 	}
 
 	if response.StatusCode != expectedStatusCode {
-		return fmt.Errorf("failed to rollback app version. Status code: %d. \n%s",
+		return nil, fmt.Errorf("failed to rollback app version. Status code: %d. \n%s",
 			response.StatusCode, responseBody)
 	}
 
-	log.Output(string(responseBody))
-	return nil
+	if len(responseBody) == 0 {
+		return nil, nil
+	}
+	log.Debug("Rollback response:", string(responseBody))
+	rollbackResponse := new(model.RollbackAppVersionResponse)
+	if err = json.Unmarshal(responseBody, rollbackResponse); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return rollbackResponse, nil
 }
 
 func (vs *versionService) DeleteAppVersion(ctx service.Context, applicationKey, version string) error {
@@ -135,3 +155,138 @@ func (vs *versionService) UpdateAppVersion(ctx service.Context, applicationKey s
 	log.Info("Application version updated successfully.")
 	return nil
 }
+
+func (vs *versionService) ListAppVersions(ctx service.Context, applicationKey string, params map[string]string) (*model.ListAppVersionsResponse, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions", applicationKey)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list app versions. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	listResponse := new(model.ListAppVersionsResponse)
+	if err = json.Unmarshal(responseBody, listResponse); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return listResponse, nil
+}
+
+func (vs *versionService) GetAppVersion(ctx service.Context, applicationKey string, version string) (*model.AppVersion, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s", applicationKey, version)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\" version \"%s\"", ErrVersionNotFound, applicationKey, version))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get app version. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	appVersion := new(model.AppVersion)
+	if err = json.Unmarshal(responseBody, appVersion); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return appVersion, nil
+}
+
+// GetAppVersionContent returns the releasables resolved into the version, including their artifacts.
+func (vs *versionService) GetAppVersionContent(ctx service.Context, applicationKey string, version string) (*model.VersionContent, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/content", applicationKey, version)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, map[string]string{"include": "releasables_expanded"})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\" version \"%s\"", ErrVersionNotFound, applicationKey, version))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get app version content. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	content := new(model.VersionContent)
+	if err = json.Unmarshal(responseBody, content); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return content, nil
+}
+
+func (vs *versionService) GetAppVersionHistory(ctx service.Context, applicationKey string, version string) (*model.AppVersionHistory, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/history", applicationKey, version)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\" version \"%s\"", ErrVersionNotFound, applicationKey, version))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get app version history. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	history := new(model.AppVersionHistory)
+	if err = json.Unmarshal(responseBody, history); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return history, nil
+}
+
+func (vs *versionService) AddAppVersionEvidence(ctx service.Context, applicationKey string, version string, envelope *model.DsseEnvelope) (*model.Evidence, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/evidence", applicationKey, version)
+	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, envelope, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\" version \"%s\"", ErrVersionNotFound, applicationKey, version))
+	}
+
+	if response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to add evidence to app version. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	evidence := new(model.Evidence)
+	if err = json.Unmarshal(responseBody, evidence); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return evidence, nil
+}
+
+func (vs *versionService) ListAppVersionEvidence(ctx service.Context, applicationKey string, version string) (*model.ListEvidenceResponse, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/evidence", applicationKey, version)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckError(fmt.Errorf("%w: \"%s\" version \"%s\"", ErrVersionNotFound, applicationKey, version))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list app version evidence. Status code: %d.\n%s",
+			response.StatusCode, responseBody)
+	}
+
+	evidence := new(model.ListEvidenceResponse)
+	if err = json.Unmarshal(responseBody, evidence); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return evidence, nil
+}
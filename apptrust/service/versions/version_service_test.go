@@ -514,7 +514,7 @@ func TestRollbackAppVersion(t *testing.T) {
 				Return(&http.Response{StatusCode: tt.expectedStatus}, []byte(""), nil)
 
 			service := NewVersionService()
-			err := service.RollbackAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
+			_, err := service.RollbackAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -525,3 +525,419 @@ func TestRollbackAppVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestListAppVersions(t *testing.T) {
+	params := map[string]string{"limit": "2", "offset": "0"}
+
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.ListAppVersionsResponse
+		expectedError    string
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockResponseBody: `{"versions":[{"version":"1.0.0","status":"COMPLETED","current_stage":"QA"}],"total":1,"limit":2,"offset":0}`,
+			expected: &model.ListAppVersionsResponse{
+				Versions: []model.AppVersion{{Version: "1.0.0", Status: "COMPLETED", CurrentStage: "QA"}},
+				Total:    1,
+				Limit:    2,
+			},
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "failed to list app versions. Status code: 404.\nnot found",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions", params).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			listResponse, err := service.ListAppVersions(mockCtx, "test-app", params)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, listResponse)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, listResponse)
+			}
+		})
+	}
+}
+
+func TestGetAppVersion(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.AppVersion
+		expectedError    string
+		expectedNotFound bool
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockResponseBody: `{"version":"1.0.0","status":"COMPLETED","release_status":"released","current_stage":"PROD","tag":"rc","properties":{"status":["rc"]},"created_by":"admin","created":"2025-01-01T10:00:00Z"}`,
+			expected: &model.AppVersion{
+				Version:       "1.0.0",
+				Status:        "COMPLETED",
+				ReleaseStatus: "released",
+				CurrentStage:  "PROD",
+				Tag:           "rc",
+				Properties:    map[string][]string{"status": {"rc"}},
+				CreatedBy:     "admin",
+				Created:       "2025-01-01T10:00:00Z",
+			},
+		},
+		{
+			name:             "not found",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "application version not found: \"test-app\" version \"1.0.0\"",
+			expectedNotFound: true,
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusInternalServerError},
+			mockResponseBody: "error",
+			expectedError:    "failed to get app version. Status code: 500.\nerror",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0", nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			appVersion, err := service.GetAppVersion(mockCtx, "test-app", "1.0.0")
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, appVersion)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Equal(t, tt.expectedNotFound, errors.Is(err, ErrVersionNotFound))
+				assert.Nil(t, appVersion)
+			}
+		})
+	}
+}
+
+func TestGetAppVersionContent(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.VersionContent
+		expectedError    string
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockResponseBody: `{"application_key":"test-app","version":"1.0.0","status":"COMPLETED","releasables":[{"name":"pkg","version":"2.0.0","package_type":"npm","repository_key":"npm-local","artifacts":[{"path":"npm-local/pkg/-/pkg-2.0.0.tgz","sha256":"abc"}]}],"versions":[{"application_key":"lib-app","version":"3.0.0"}]}`,
+			expected: &model.VersionContent{
+				ApplicationKey: "test-app",
+				Version:        "1.0.0",
+				Status:         "COMPLETED",
+				Releasables: []model.Releasable{{
+					Name:          "pkg",
+					Version:       "2.0.0",
+					PackageType:   "npm",
+					RepositoryKey: "npm-local",
+					Artifacts:     []model.ReleasableArtifact{{Path: "npm-local/pkg/-/pkg-2.0.0.tgz", Sha256: "abc"}},
+				}},
+				Versions: []model.CreateVersionReference{{ApplicationKey: "lib-app", Version: "3.0.0"}},
+			},
+		},
+		{
+			name:             "not found",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "application version not found: \"test-app\" version \"1.0.0\"",
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusInternalServerError},
+			mockResponseBody: "error",
+			expectedError:    "failed to get app version content. Status code: 500.\nerror",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", map[string]string{"include": "releasables_expanded"}).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			content, err := service.GetAppVersionContent(mockCtx, "test-app", "1.0.0")
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, content)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, content)
+			}
+		})
+	}
+}
+
+func TestRollbackAppVersion_Response(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockClient)
+
+	payload := &model.RollbackAppVersionRequest{FromStage: "prod"}
+	responseBody := `{"application_key":"video-encoder","version":"1.5.0","project_key":"proj","rollback_from_stage":"prod","rollback_to_stage":"qa"}`
+	mockClient.EXPECT().Post("/v1/applications/video-encoder/versions/1.5.0/rollback", payload, map[string]string{"async": "false"}).
+		Return(&http.Response{StatusCode: http.StatusOK}, []byte(responseBody), nil)
+
+	service := NewVersionService()
+	response, err := service.RollbackAppVersion(mockCtx, "video-encoder", "1.5.0", payload, true)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.RollbackAppVersionResponse{
+		AppVersionReference: model.AppVersionReference{
+			ApplicationKey: "video-encoder",
+			Version:        "1.5.0",
+			ProjectKey:     "proj",
+		},
+		RollbackFromStage: "prod",
+		RollbackToStage:   "qa",
+	}, response)
+}
+
+func TestGetAppVersionHistory(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.AppVersionHistory
+		expectedError    string
+	}{
+		{
+			name:         "success",
+			mockResponse: &http.Response{StatusCode: http.StatusOK},
+			mockResponseBody: `{"application_key":"test-app","version":"1.0.0","project_key":"proj","events":[` +
+				`{"event_type":"promotion","status":"COMPLETED","to_stage":"QA","created_by":"admin","created":"2025-01-01T10:00:00Z"},` +
+				`{"event_type":"rollback","status":"COMPLETED","from_stage":"QA","created_by":"admin","created":"2025-01-02T10:00:00Z"}]}`,
+			expected: &model.AppVersionHistory{
+				AppVersionReference: model.AppVersionReference{ApplicationKey: "test-app", Version: "1.0.0", ProjectKey: "proj"},
+				Events: []model.VersionHistoryEvent{
+					{EventType: "promotion", Status: "COMPLETED", ToStage: "QA", CreatedBy: "admin", Created: "2025-01-01T10:00:00Z"},
+					{EventType: "rollback", Status: "COMPLETED", FromStage: "QA", CreatedBy: "admin", Created: "2025-01-02T10:00:00Z"},
+				},
+			},
+		},
+		{
+			name:             "not found",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "application version not found: \"test-app\" version \"1.0.0\"",
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusInternalServerError},
+			mockResponseBody: "error",
+			expectedError:    "failed to get app version history. Status code: 500.\nerror",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/history", nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			history, err := service.GetAppVersionHistory(mockCtx, "test-app", "1.0.0")
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, history)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, history)
+			}
+		})
+	}
+}
+
+func TestAddAppVersionEvidence(t *testing.T) {
+	envelope := &model.DsseEnvelope{PayloadType: model.InTotoPayloadType, Payload: "e30=", Signatures: []model.DsseSignature{}}
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.Evidence
+		expectedError    string
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: http.StatusCreated},
+			mockResponseBody: `{"id":"ev-1","predicate_type":"https://slsa.dev/provenance/v1","created_by":"admin","signed":false}`,
+			expected:         &model.Evidence{Id: "ev-1", PredicateType: "https://slsa.dev/provenance/v1", CreatedBy: "admin"},
+		},
+		{
+			name:             "not found",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "application version not found: \"test-app\" version \"1.0.0\"",
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusBadRequest},
+			mockResponseBody: "invalid envelope",
+			expectedError:    "failed to add evidence to app version. Status code: 400.\ninvalid envelope",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Post("/v1/applications/test-app/versions/1.0.0/evidence", envelope, nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			evidence, err := service.AddAppVersionEvidence(mockCtx, "test-app", "1.0.0", envelope)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, evidence)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, evidence)
+			}
+		})
+	}
+}
+
+func TestListAppVersionEvidence(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockResponseBody string
+		mockError        error
+		expected         *model.ListEvidenceResponse
+		expectedError    string
+	}{
+		{
+			name:             "success",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockResponseBody: `{"evidence":[{"id":"ev-1","predicate_type":"https://slsa.dev/provenance/v1","signed":true,"key_id":"ci-key"}]}`,
+			expected: &model.ListEvidenceResponse{Evidence: []model.Evidence{
+				{Id: "ev-1", PredicateType: "https://slsa.dev/provenance/v1", Signed: true, KeyId: "ci-key"},
+			}},
+		},
+		{
+			name:             "not found",
+			mockResponse:     &http.Response{StatusCode: http.StatusNotFound},
+			mockResponseBody: "not found",
+			expectedError:    "application version not found: \"test-app\" version \"1.0.0\"",
+		},
+		{
+			name:             "failure",
+			mockResponse:     &http.Response{StatusCode: http.StatusInternalServerError},
+			mockResponseBody: "error",
+			expectedError:    "failed to list app version evidence. Status code: 500.\nerror",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/evidence", nil).
+				Return(tt.mockResponse, []byte(tt.mockResponseBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			service := NewVersionService()
+			evidence, err := service.ListAppVersionEvidence(mockCtx, "test-app", "1.0.0")
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, evidence)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, evidence)
+			}
+		})
+	}
+}
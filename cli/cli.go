@@ -23,15 +23,35 @@ func GetJfrogCliApptrustApp() components.App {
 				system.GetPingCommand(appContext),
 				version.GetCreateAppVersionCommand(appContext),
 				version.GetPromoteAppVersionCommand(appContext),
+				version.GetPromoteChainAppVersionCommand(appContext),
 				version.GetRollbackAppVersionCommand(appContext),
 				version.GetReleaseAppVersionCommand(appContext),
 				version.GetDeleteAppVersionCommand(appContext),
 				version.GetUpdateAppVersionCommand(appContext),
+				version.GetGetAppVersionCommand(appContext),
+				version.GetListAppVersionsCommand(appContext),
+				version.GetVersionContentCommand(appContext),
+				version.GetWaitAppVersionCommand(appContext),
+				version.GetDiffAppVersionsCommand(appContext),
+				version.GetPruneAppVersionsCommand(appContext),
+				version.GetAddVersionEvidenceCommand(appContext),
+				version.GetListVersionEvidenceCommand(appContext),
+				version.GetVersionHistoryCommand(appContext),
+				version.GetDownloadAppVersionCommand(appContext),
+				version.GetVerifyAppVersionCommand(appContext),
+				version.GetVersionSbomCommand(appContext),
 				packagecmds.GetBindPackageCommand(appContext),
 				packagecmds.GetUnbindPackageCommand(appContext),
 				application.GetCreateAppCommand(appContext),
+				application.GetGetAppCommand(appContext),
+				application.GetListAppsCommand(appContext),
 				application.GetUpdateAppCommand(appContext),
 				application.GetDeleteAppCommand(appContext),
+				application.GetApplyAppCommand(appContext),
+				application.GetExportAppCommand(appContext),
+				application.GetCloneAppCommand(appContext),
+				application.GetImportAppsCommand(appContext),
+				application.GetDescribeAppCommand(appContext),
 			},
 		},
 	)
@@ -3,11 +3,14 @@
 package e2e
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-application/e2e/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateApp(t *testing.T) {
@@ -47,6 +50,18 @@ func TestCreateApp(t *testing.T) {
 	utils.DeleteApplication(t, appKey)
 }
 
+func TestGetApp(t *testing.T) {
+	projectKey := utils.GetTestProjectKey(t)
+	appKey := utils.GenerateUniqueKey("app-get")
+	utils.CreateBasicApplication(t, appKey)
+
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "app-get", appKey)
+	assert.Contains(t, output, `"application_key": "`+appKey+`"`)
+	assert.Contains(t, output, `"project_key": "`+projectKey+`"`)
+
+	utils.DeleteApplication(t, appKey)
+}
+
 func TestUpdateApp(t *testing.T) {
 	projectKey := utils.GetTestProjectKey(t)
 	appKey := utils.GenerateUniqueKey("app-update")
@@ -86,6 +101,93 @@ func TestUpdateApp(t *testing.T) {
 	utils.DeleteApplication(t, appKey)
 }
 
+func TestUpdateAppOwners(t *testing.T) {
+	appKey := utils.GenerateUniqueKey("app-update-owners")
+	utils.CreateBasicApplication(t, appKey)
+
+	err := utils.AppTrustCli.Exec("app-update", appKey, "--user-owners=admin;developer")
+	assert.NoError(t, err)
+
+	err = utils.AppTrustCli.Exec("app-update", appKey,
+		"--add-user-owners=frog",
+		"--remove-user-owners=developer",
+		"--add-group-owners=devops-team")
+	assert.NoError(t, err)
+
+	app, _, err := utils.GetApplication(appKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admin", "frog"}, *app.UserOwners)
+	assert.Equal(t, []string{"devops-team"}, *app.GroupOwners)
+
+	utils.DeleteApplication(t, appKey)
+}
+
+func TestCloneApp(t *testing.T) {
+	sourceKey := utils.GenerateUniqueKey("app-clone-source")
+	projectKey := utils.GetTestProjectKey(t)
+	err := utils.AppTrustCli.Exec("app-create", sourceKey,
+		"--project="+projectKey,
+		"--business-criticality=high",
+		"--maturity-level=production",
+		"--labels=team=devops",
+		"--user-owners=admin")
+	assert.NoError(t, err)
+
+	targetKey := utils.GenerateUniqueKey("app-clone-target")
+	err = utils.AppTrustCli.Exec("app-clone", sourceKey, targetKey)
+	assert.NoError(t, err)
+
+	app, _, err := utils.GetApplication(targetKey)
+	assert.NoError(t, err)
+	assert.Equal(t, targetKey, app.ApplicationKey)
+	assert.Equal(t, projectKey, app.ProjectKey)
+	assert.Equal(t, "high", *app.BusinessCriticality)
+	assert.Equal(t, "production", *app.MaturityLevel)
+	assert.Equal(t, map[string]string{"team": "devops"}, *app.Labels)
+	assert.Equal(t, []string{"admin"}, *app.UserOwners)
+
+	utils.DeleteApplication(t, targetKey)
+	utils.DeleteApplication(t, sourceKey)
+}
+
+func TestImportApps(t *testing.T) {
+	projectKey := utils.GetTestProjectKey(t)
+	firstKey := utils.GenerateUniqueKey("app-import-1")
+	secondKey := utils.GenerateUniqueKey("app-import-2")
+
+	csvPath := filepath.Join(t.TempDir(), "apps.csv")
+	content := "application_key,project_key,criticality,labels\n" +
+		firstKey + "," + projectKey + ",high,team=devops\n" +
+		secondKey + "," + projectKey + ",low,\n"
+	require.NoError(t, os.WriteFile(csvPath, []byte(content), 0o644))
+
+	err := utils.AppTrustCli.Exec("app-import", "--file="+csvPath)
+	assert.NoError(t, err)
+
+	app, _, err := utils.GetApplication(firstKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "high", *app.BusinessCriticality)
+	assert.Equal(t, map[string]string{"team": "devops"}, *app.Labels)
+
+	app, _, err = utils.GetApplication(secondKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "low", *app.BusinessCriticality)
+
+	utils.DeleteApplication(t, firstKey)
+	utils.DeleteApplication(t, secondKey)
+}
+
+func TestDescribeApp(t *testing.T) {
+	appKey := utils.GenerateUniqueKey("app-describe")
+	utils.CreateBasicApplication(t, appKey)
+
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "app-describe", appKey, "--format=json")
+	assert.Contains(t, output, `"application_key": "`+appKey+`"`)
+	assert.Contains(t, output, `"total_versions": 0`)
+
+	utils.DeleteApplication(t, appKey)
+}
+
 func TestDeleteApp(t *testing.T) {
 	appKey := utils.GenerateUniqueKey("app-delete")
 	utils.CreateBasicApplication(t, appKey)
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -234,6 +236,123 @@ func TestUpdateVersion(t *testing.T) {
 	assert.Equal(t, tag, versionContent.Tag)
 }
 
+func TestUpdateVersion_PropertiesFile(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-update-props-file")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.17"
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	propertiesPath := filepath.Join(t.TempDir(), "props.yaml")
+	content := "commit_message:\n  - \"Fix a, b; and c\"\n"
+	require.NoError(t, os.WriteFile(propertiesPath, []byte(content), 0o644))
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-update", appKey, version, "--properties-file="+propertiesPath)
+	require.NoError(t, err)
+
+	// Assert
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-get", appKey, version)
+	var appVersion struct {
+		Properties map[string][]string `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &appVersion))
+	assert.Equal(t, []string{"Fix a, b; and c"}, appVersion.Properties["commit_message"])
+}
+
+func TestGetVersion(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-get")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.9"
+
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag, "--tag=get-tag")
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-get", appKey, version)
+
+	// Assert
+	assert.Contains(t, output, `"version": "`+version+`"`)
+	assert.Contains(t, output, `"tag": "get-tag"`)
+}
+
+func TestListVersions(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-list")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	for _, version := range []string{"1.2.0", "1.10.0"} {
+		err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag, "--tag=list-"+version)
+		require.NoError(t, err)
+		defer utils.DeleteApplicationVersion(t, appKey, version)
+	}
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-list", appKey, "--sort-by=semver", "--sort-order=asc")
+
+	// Assert
+	var listedVersions []struct {
+		Version string `json:"version"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &listedVersions))
+	require.Len(t, listedVersions, 2)
+	assert.Equal(t, "1.2.0", listedVersions[0].Version)
+	assert.Equal(t, "1.10.0", listedVersions[1].Version)
+
+	output = utils.AppTrustCli.RunCliCmdWithOutput(t, "version-list", appKey, "--tag=list-1.10.0")
+	assert.Contains(t, output, `"version": "1.10.0"`)
+	assert.NotContains(t, output, `"version": "1.2.0"`)
+}
+
+func TestVersionContent(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-content")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.10"
+
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-content", appKey, version, "--format=json")
+
+	// Assert
+	var content struct {
+		Packages []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"packages"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &content))
+	require.Len(t, content.Packages, 1)
+	assert.Equal(t, testPackage.PackageName, content.Packages[0].Name)
+	assert.Equal(t, testPackage.PackageVersion, content.Packages[0].Version)
+}
+
 func TestDeleteVersion(t *testing.T) {
 	// Prepare
 	appKey := utils.GenerateUniqueKey("app-version-delete")
@@ -266,6 +385,43 @@ func TestDeleteVersion(t *testing.T) {
 	assert.Equal(t, 404, statusCode)
 }
 
+func TestPruneVersions(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-prune")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	prunedVersion := "1.0.15"
+	keptVersion := "1.0.16"
+	for _, version := range []string{prunedVersion, keptVersion} {
+		err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+		require.NoError(t, err)
+	}
+	defer utils.DeleteApplicationVersion(t, appKey, keptVersion)
+
+	// Execute a dry run
+	err := utils.AppTrustCli.Exec("version-prune", appKey, "--keep-last=1", "--dry-run")
+	require.NoError(t, err)
+	_, statusCode, err := utils.GetApplicationVersion(appKey, prunedVersion)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-prune", appKey, "--keep-last=1", "--force")
+	require.NoError(t, err)
+
+	// Assert
+	_, statusCode, err = utils.GetApplicationVersion(appKey, prunedVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	_, statusCode, err = utils.GetApplicationVersion(appKey, keptVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
 func TestPromoteVersion(t *testing.T) {
 	// Prepare
 	appKey := utils.GenerateUniqueKey("app-version-promote")
@@ -367,3 +523,344 @@ func TestRollbackVersion(t *testing.T) {
 	assert.Equal(t, version, versionContent.Version)
 	assert.Empty(t, versionContent.CurrentStage)
 }
+
+func TestRollbackVersion_RestorePrevious(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-rollback-restore")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	targetStage := "DEV"
+	previousVersion := "1.0.13"
+	version := "1.0.14"
+	for _, v := range []string{previousVersion, version} {
+		err := utils.AppTrustCli.Exec("version-create", appKey, v, packageFlag)
+		require.NoError(t, err)
+		defer utils.DeleteApplicationVersion(t, appKey, v)
+		err = utils.AppTrustCli.Exec("version-promote", appKey, v, targetStage)
+		require.NoError(t, err)
+	}
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-rollback", appKey, version, targetStage, "--restore-previous")
+
+	// Assert
+	assert.Contains(t, output, fmt.Sprintf("Restored version %s as the version promoted to stage %s.", previousVersion, targetStage))
+	versionContent, statusCode, err := utils.GetApplicationVersion(appKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.NotEqual(t, targetStage, versionContent.CurrentStage)
+}
+
+func TestWaitVersion(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-wait")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.11"
+
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag, "--sync=false")
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-wait", appKey, version, "--for=created", "--timeout=2m")
+	require.NoError(t, err)
+	targetStage := "DEV"
+	err = utils.AppTrustCli.Exec("version-promote", appKey, version, targetStage, "--wait", "--timeout=2m")
+	require.NoError(t, err)
+
+	// Assert
+	versionContent, statusCode, err := utils.GetApplicationVersion(appKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	require.NotNil(t, versionContent)
+	assert.Equal(t, utils.StatusCompleted, versionContent.Status)
+	assert.Equal(t, targetStage, versionContent.CurrentStage)
+}
+
+func TestDiffVersions(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-diff")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	for _, version := range []string{"1.3.0", "1.4.0"} {
+		err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag, "--tag=diff-"+version)
+		require.NoError(t, err)
+		defer utils.DeleteApplicationVersion(t, appKey, version)
+	}
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-diff", appKey, "1.3.0", "1.4.0", "--format=json")
+
+	// Assert
+	assert.Contains(t, output, `"from": "diff-1.3.0"`)
+	assert.Contains(t, output, `"to": "diff-1.4.0"`)
+	assert.Contains(t, output, `"packages": []`)
+	assert.Contains(t, output, `"artifacts": []`)
+}
+
+func TestPromoteVersion_LatestSelector(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-selector")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	for _, version := range []string{"1.5.0", "1.6.0"} {
+		err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+		require.NoError(t, err)
+		defer utils.DeleteApplicationVersion(t, appKey, version)
+	}
+
+	// Execute
+	targetStage := "DEV"
+	err := utils.AppTrustCli.Exec("version-promote", appKey, "latest", targetStage)
+	require.NoError(t, err)
+
+	// Assert
+	versionContent, statusCode, err := utils.GetApplicationVersion(appKey, "1.6.0")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	require.NotNil(t, versionContent)
+	assert.Equal(t, targetStage, versionContent.CurrentStage)
+}
+
+func TestCreateVersion_Bump(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-bump")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, "1.7.3", packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, "1.7.3")
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-create", appKey, "auto", packageFlag, "--bump=minor")
+	defer utils.DeleteApplicationVersion(t, appKey, "1.8.0")
+
+	// Assert
+	assert.Contains(t, output, "1.8.0")
+	versionContent, statusCode, err := utils.GetApplicationVersion(appKey, "1.8.0")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	require.NotNil(t, versionContent)
+}
+
+func TestPromoteChainVersion(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-chain")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.12"
+
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	err = utils.AppTrustCli.Exec("version-update", appKey, version, "--properties=tests=passed")
+	require.NoError(t, err)
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-promote-chain", appKey, version, "--stages=DEV,QA", "--gates=QA:tests=passed", "--timeout=5m")
+	require.NoError(t, err)
+
+	// Assert
+	versionContent, statusCode, err := utils.GetApplicationVersion(appKey, version)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	require.NotNil(t, versionContent)
+	assert.Equal(t, "QA", versionContent.CurrentStage)
+}
+
+func TestVersionEvidence(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-evidence")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.18"
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	predicatePath := filepath.Join(t.TempDir(), "predicate.json")
+	require.NoError(t, os.WriteFile(predicatePath, []byte(`{"suite": "e2e", "passed": true}`), 0o644))
+	predicateType := "https://jfrog.com/evidence/test-results/v1"
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-evidence-add", appKey, version,
+		"--predicate="+predicatePath, "--predicate-type="+predicateType)
+	require.NoError(t, err)
+
+	// Assert
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-evidence-list", appKey, version, "--format=json")
+	var evidence []struct {
+		PredicateType string `json:"predicate_type"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &evidence))
+	require.Len(t, evidence, 1)
+	assert.Equal(t, predicateType, evidence[0].PredicateType)
+}
+
+func TestVersionHistory(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-history")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.19"
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	err = utils.AppTrustCli.Exec("version-promote", appKey, version, "DEV")
+	require.NoError(t, err)
+	err = utils.AppTrustCli.Exec("version-rollback", appKey, version, "DEV")
+	require.NoError(t, err)
+
+	// Execute
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-history", appKey, version, "--stage=DEV", "--format=json")
+
+	// Assert
+	var history struct {
+		Events []struct {
+			EventType string `json:"event_type"`
+			FromStage string `json:"from_stage"`
+			ToStage   string `json:"to_stage"`
+		} `json:"events"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &history))
+	require.Len(t, history.Events, 2)
+	assert.Equal(t, "promotion", history.Events[0].EventType)
+	assert.Equal(t, "DEV", history.Events[0].ToStage)
+	assert.Equal(t, "rollback", history.Events[1].EventType)
+	assert.Equal(t, "DEV", history.Events[1].FromStage)
+}
+
+func TestDownloadVersion(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-download")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.20"
+	artifactFlag := fmt.Sprintf("--source-type-artifacts=path=%s", testPackage.PackagePath)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, artifactFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	targetDir := t.TempDir()
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-download", appKey, version, "--target="+targetDir)
+	require.NoError(t, err)
+
+	// Assert
+	assert.FileExists(t, filepath.Join(targetDir, filepath.FromSlash(testPackage.PackagePath)))
+
+	// A second run skips the artifacts that are already downloaded and verified
+	err = utils.AppTrustCli.Exec("version-download", appKey, version, "--target="+targetDir)
+	assert.NoError(t, err)
+}
+
+func TestVerifyVersion(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-verify")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.21"
+	artifactFlag := fmt.Sprintf("--source-type-artifacts=path=%s", testPackage.PackagePath)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, artifactFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	deployedDir := t.TempDir()
+	err = utils.AppTrustCli.Exec("version-download", appKey, version, "--target="+deployedDir)
+	require.NoError(t, err)
+
+	// Execute
+	err = utils.AppTrustCli.Exec("version-verify", appKey, version, "--path="+deployedDir)
+
+	// Assert
+	assert.NoError(t, err)
+
+	// A modified file fails the verification
+	deployedFile := filepath.Join(deployedDir, filepath.FromSlash(testPackage.PackagePath))
+	require.NoError(t, os.WriteFile(deployedFile, []byte("tampered"), 0644))
+	err = utils.AppTrustCli.Exec("version-verify", appKey, version, "--path="+deployedDir)
+	assert.ErrorContains(t, err, "verification failed: 1 mismatched and 0 missing file(s)")
+}
+
+func TestVersionSbom(t *testing.T) {
+	// Prepare
+	appKey := utils.GenerateUniqueKey("app-version-sbom")
+	utils.CreateBasicApplication(t, appKey)
+	defer utils.DeleteApplication(t, appKey)
+
+	testPackage := utils.GetTestPackage(t)
+	version := "1.0.22"
+	packageFlag := fmt.Sprintf("--source-type-packages=type=%s, name=%s, version=%s, repo-key=%s",
+		testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion, testPackage.RepoKey)
+	err := utils.AppTrustCli.Exec("version-create", appKey, version, packageFlag)
+	require.NoError(t, err)
+	defer utils.DeleteApplicationVersion(t, appKey, version)
+
+	// Execute
+	cycloneDxOutput := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-sbom", appKey, version, "--format=cyclonedx-json")
+	spdxOutput := utils.AppTrustCli.RunCliCmdWithOutput(t, "version-sbom", appKey, version, "--format=spdx-json")
+
+	// Assert
+	var bom struct {
+		BomFormat  string `json:"bomFormat"`
+		Components []struct {
+			Name string `json:"name"`
+			Purl string `json:"purl"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(cycloneDxOutput), &bom))
+	assert.Equal(t, "CycloneDX", bom.BomFormat)
+	require.Len(t, bom.Components, 1)
+	assert.Equal(t, testPackage.PackageName, bom.Components[0].Name)
+	assert.Contains(t, bom.Components[0].Purl, "pkg:npm/")
+
+	var document struct {
+		SpdxVersion string `json:"spdxVersion"`
+		Packages    []struct {
+			Name string `json:"name"`
+		} `json:"packages"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(spdxOutput), &document))
+	assert.Equal(t, "SPDX-2.3", document.SpdxVersion)
+	assert.GreaterOrEqual(t, len(document.Packages), 2)
+}